@@ -0,0 +1,184 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// StripAccents Removes diacritics (~= accents) from a string. The case will not be altered.
+// Characters are decomposed and their combining marks dropped, "Ł" and "ł" become "L" and "l".
+//  stringutils.StripAccents("")              = ""
+//  stringutils.StripAccents("control")       = "control"
+//  stringutils.StripAccents("éclair")        = "eclair"
+//  stringutils.StripAccents("Ångström café") = "Angstrom cafe"
+//  stringutils.StripAccents("Łódź")          = "Lodz"
+func StripAccents(s string) string {
+	if isASCII(s) {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range Normalize(s, NFD) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'Ł':
+			r = 'L'
+		case r == 'ł':
+			r = 'l'
+		}
+		b.WriteRune(r)
+	}
+	return Normalize(b.String(), NFC)
+}
+
+// ASCIITable maps runes to their ASCII transliteration, an empty string drops the rune.
+type ASCIITable map[rune]string
+
+// asciiLower holds the transliterations of lower case letters, upper case letters
+// are derived from them with the first letter capitalized.
+var asciiLower = map[rune]string{
+	// Latin letters and ligatures without a decomposition
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th", 'ł': "l",
+	'ı': "i", 'ĸ': "q", 'ŋ': "ng", 'ħ': "h", 'ŧ': "t", 'ƒ': "f", 'ə': "e", 'ɨ': "i",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz", 'ѕ': "dz", 'ѓ': "gj", 'ќ': "kj",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+	'ω': "o",
+}
+
+// asciiOther holds the transliterations of caseless characters and of the capital
+// ligatures, which are written in all caps.
+var asciiOther = map[rune]string{
+	'ẞ': "SS", 'Æ': "AE", 'Œ': "OE", 'Þ': "TH", 'Ŋ': "NG", '‘': "'", '’': "'", '‚': "'", '‛': "'", '“': "\"", '”': "\"", '„': "\"",
+	'‟': "\"", '«': "<<", '»': ">>", '‹': "<", '›': ">", '‐': "-", '‑': "-", '‒': "-",
+	'–': "-", '—': "-", '―': "-", '−': "-", '•': "*", '·': ".", '×': "x", '÷': "/",
+	'©': "(c)", '®': "(R)", '°': "deg", '€': "EUR", '£': "GBP", '¥': "JPY", '¢': "c",
+	'¡': "!", '¿': "?", '§': "S", '¶': "P",
+}
+
+// DefaultASCIITable Returns a copy of the built-in transliteration table, covering Latin letters
+// and ligatures without a decomposition, Cyrillic, Greek and common punctuation. The copy may be
+// extended and passed to ToASCIIWithTable.
+func DefaultASCIITable() ASCIITable {
+	table := make(ASCIITable, 2*len(asciiLower)+len(asciiOther))
+	for r, s := range asciiLower {
+		table[r] = s
+		if u := unicode.ToUpper(r); u != r && u >= utf8.RuneSelf {
+			if _, ok := asciiLower[u]; !ok {
+				table[u] = capitalizeASCII(s)
+			}
+		}
+	}
+	for r, s := range asciiOther {
+		table[r] = s
+	}
+	return table
+}
+
+var defaultASCIITable = DefaultASCIITable()
+
+// capitalizeASCII upper-cases the first letter of an ASCII string.
+func capitalizeASCII(s string) string {
+	if IsEmpty(s) {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// ToASCII Transliterates s to ASCII with the default table, see ToASCIIWithTable.
+//  stringutils.ToASCII("")              = "", []
+//  stringutils.ToASCII("Ångström café") = "Angstrom cafe", []
+//  stringutils.ToASCII("Straße")        = "Strasse", []
+//  stringutils.ToASCII("Щука")          = "Shchuka", []
+//  stringutils.ToASCII("ЩУКА")          = "SHCHUKA", []
+//  stringutils.ToASCII("Αθήνα")         = "Athina", []
+//  stringutils.ToASCII("日本")           = "", ['日', '本']
+func ToASCII(s string) (string, []rune) {
+	return ToASCIIWithTable(s, defaultASCIITable)
+}
+
+// ToASCIIWithTable Transliterates s to ASCII. Each rune is looked up in the table, otherwise its
+// compatibility decomposition without combining marks is used when that is ASCII or found in the
+// table. Runes with no mapping are dropped and returned in order of first appearance. The
+// transliteration of an upper case rune is written in all caps in an all caps word, "Щ" is "Shch"
+// in "Щука" and "SHCH" in "ЩУКА" and "ПЛАЩ".
+//  stringutils.ToASCIIWithTable("ﬁancé", nil)                  = "fiance", []
+//  stringutils.ToASCIIWithTable("Æon", nil)                    = "on", ['Æ']
+//  stringutils.ToASCIIWithTable("Æon", ASCIITable{'Æ': "Ae"})  = "Aeon", []
+func ToASCIIWithTable(s string, table ASCIITable) (string, []rune) {
+	if isASCII(s) {
+		return s, nil
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	var unmapped []rune
+	seen := make(map[rune]bool)
+	prev := rune(-1)
+	for i, r := range s {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			prev = r
+			continue
+		}
+		t, ok := table[r]
+		if !ok {
+			t, ok = transliterateDecomposed(r, table)
+		}
+		if ok {
+			if isAllCapsWord(r, prev, s[i+utf8.RuneLen(r):]) {
+				t = strings.ToUpper(t)
+			}
+			b.WriteString(t)
+		} else if !seen[r] {
+			seen[r] = true
+			unmapped = append(unmapped, r)
+		}
+		prev = r
+	}
+	return b.String(), unmapped
+}
+
+// isAllCapsWord reports whether the upper case rune r is in a word written in all caps, so that
+// its transliteration is too: the letter after it is upper case or, at the end of the word, the
+// letter before it is.
+func isAllCapsWord(r, prev rune, rest string) bool {
+	if !unicode.IsUpper(r) {
+		return false
+	}
+	if next, _ := utf8.DecodeRuneInString(rest); unicode.IsLetter(next) {
+		return unicode.IsUpper(next)
+	}
+	return unicode.IsUpper(prev)
+}
+
+// transliterateDecomposed transliterates the compatibility decomposition of r, false if
+// any of its runes other than combining marks has no mapping.
+func transliterateDecomposed(r rune, table ASCIITable) (string, bool) {
+	d := appendDecomposed(nil, r, true)
+	if len(d) == 1 && d[0] == r {
+		return "", false
+	}
+	var b strings.Builder
+	for _, r := range d {
+		switch t, ok := table[r]; {
+		case r < utf8.RuneSelf:
+			b.WriteRune(r)
+		case ok:
+			b.WriteString(t)
+		case unicode.Is(unicode.Mn, r):
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
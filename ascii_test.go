@@ -0,0 +1,109 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripAccents(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{""}, ""},
+		{"control", args{"control"}, "control"},
+		{"éclair", args{"éclair"}, "eclair"},
+		{"Ångström café", args{"Ångström café"}, "Angstrom cafe"},
+		{"decomposed", args{"Amélie"}, "Amelie"},
+		{"Łódź", args{"Łódź"}, "Lodz"},
+		{"Straße", args{"Straße"}, "Straße"},
+		{"hangul", args{"각"}, "각"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripAccents(tt.args.s); got != tt.want {
+				t.Errorf("StripAccents() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         string
+		wantUnmapped []rune
+	}{
+		{"empty", args{""}, "", nil},
+		{"abc", args{"abc"}, "abc", nil},
+		{"Ångström café", args{"Ångström café"}, "Angstrom cafe", nil},
+		{"Straße", args{"Straße"}, "Strasse", nil},
+		{"ligatures", args{"Œuvre ﬁnale Æsir"}, "OEuvre finale AEsir", nil},
+		{"Щука", args{"Щука"}, "Shchuka", nil},
+		{"ЩУКА", args{"ЩУКА"}, "SHCHUKA", nil},
+		{"ПЛАЩ", args{"ПЛАЩ"}, "PLASHCH", nil},
+		{"Щ", args{"Щ"}, "Shch", nil},
+		{"ЖЁЛТЫЙ ЧАЙ", args{"ЖЁЛТЫЙ ЧАЙ"}, "ZHYOLTYY CHAY", nil},
+		{"Жук", args{"Жук"}, "Zhuk", nil},
+		{"ΘΕΑ", args{"ΘΕΑ"}, "THEA", nil},
+		{"Київ", args{"Київ"}, "Kiyiv", nil},
+		{"Αθήνα", args{"Αθήνα"}, "Athina", nil},
+		{"quotes", args{"“quoted” – ‘text’"}, "\"quoted\" - 'text'", nil},
+		{"fullwidth", args{"ＡＢＣ"}, "ABC", nil},
+		{"日本", args{"日本 日"}, " ", []rune{'日', '本'}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotUnmapped := ToASCII(tt.args.s)
+			if got != tt.want {
+				t.Errorf("ToASCII() got = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotUnmapped, tt.wantUnmapped) {
+				t.Errorf("ToASCII() gotUnmapped = %q, want %q", gotUnmapped, tt.wantUnmapped)
+			}
+		})
+	}
+}
+
+func TestToASCIIWithTable(t *testing.T) {
+	type args struct {
+		s     string
+		table ASCIITable
+	}
+	tests := []struct {
+		name         string
+		args         args
+		want         string
+		wantUnmapped []rune
+	}{
+		{"nil table", args{"ﬁancé", nil}, "fiance", nil},
+		{"nil table unmapped", args{"Æon", nil}, "on", []rune{'Æ'}},
+		{"custom", args{"Æon", ASCIITable{'Æ': "Ae"}}, "Aeon", nil},
+		{"drop", args{"a→b", ASCIITable{'→': ""}}, "ab", nil},
+		{"extended default", args{"ä日", func() ASCIITable {
+			table := DefaultASCIITable()
+			table['ä'] = "ae"
+			table['日'] = "ri"
+			return table
+		}()}, "aeri", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotUnmapped := ToASCIIWithTable(tt.args.s, tt.args.table)
+			if got != tt.want {
+				t.Errorf("ToASCIIWithTable() got = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotUnmapped, tt.wantUnmapped) {
+				t.Errorf("ToASCIIWithTable() gotUnmapped = %q, want %q", gotUnmapped, tt.wantUnmapped)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package stringutils
+
+import (
+	_ "embed" // block ranges
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed data/Blocks.txt
+var blocksTxt string
+
+// blockRange is one entry of Blocks.txt.
+type blockRange struct {
+	lo, hi rune
+	name   string
+}
+
+var (
+	blocksOnce  sync.Once
+	blockTable  []blockRange
+	blockByName map[string]blockRange
+)
+
+// loadBlocks parses the embedded Blocks.txt, lines have the form
+//  <start>..<end>; <block name>
+func loadBlocks() {
+	blockByName = make(map[string]blockRange)
+	for _, line := range strings.Split(blocksTxt, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) != 2 {
+			continue
+		}
+		bounds := parseCodePoints(strings.Replace(fields[0], "..", " ", 1))
+		if len(bounds) != 2 {
+			continue
+		}
+		b := blockRange{bounds[0], bounds[1], strings.TrimSpace(fields[1])}
+		blockTable = append(blockTable, b)
+		blockByName[blockKey(b.name)] = b
+	}
+	sort.Slice(blockTable, func(i, j int) bool { return blockTable[i].lo < blockTable[j].lo })
+}
+
+// blockKey returns the block name for comparisons, which ignore case, whitespace, hyphens and
+// underscores as in UAX #44.
+func blockKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// BlockOf Returns the name of the Unicode block of the rune as in Blocks.txt, "" if it is in no block.
+//  stringutils.BlockOf('a')          = "Basic Latin"
+//  stringutils.BlockOf('é')          = "Latin-1 Supplement"
+//  stringutils.BlockOf('\u0430')     = "Cyrillic"
+//  stringutils.BlockOf('\U0001F600') = "Emoticons"
+//  stringutils.BlockOf('\U000E0080') = ""
+func BlockOf(r rune) string {
+	blocksOnce.Do(loadBlocks)
+	i := sort.Search(len(blockTable), func(i int) bool { return blockTable[i].hi >= r })
+	if i < len(blockTable) && blockTable[i].lo <= r {
+		return blockTable[i].name
+	}
+	return ""
+}
+
+// Blocks Returns the number of runes of s in every Unicode block, by the block names of Blocks.txt.
+// Unlike Scripts every rune is counted, whitespace, digits and punctuation included, but those in no block.
+//  stringutils.Blocks("")            = map[]
+//  stringutils.Blocks("ab 1")        = map[Basic Latin:4]
+//  stringutils.Blocks("café")        = map[Basic Latin:3 Latin-1 Supplement:1]
+//  stringutils.Blocks("p\u0430ypal") = map[Basic Latin:5 Cyrillic:1]
+func Blocks(s string) map[string]int {
+	counts := make(map[string]int)
+	for _, r := range s {
+		if name := BlockOf(r); name != "" {
+			counts[name]++
+		}
+	}
+	return counts
+}
+
+// lookupBlock returns the block of the name, compared as in UAX #44 so that "latin_1_supplement"
+// is "Latin-1 Supplement", false if there is no such block.
+func lookupBlock(name string) (blockRange, bool) {
+	blocksOnce.Do(loadBlocks)
+	b, ok := blockByName[blockKey(name)]
+	return b, ok
+}
+
+// IsAllBlock Checks if all the runes of s are in the Unicode block, named as in Blocks.txt with
+// case, whitespace, hyphens and underscores ignored. False if s is empty or there is no such block.
+//  stringutils.IsAllBlock("", "Basic Latin")          = false
+//  stringutils.IsAllBlock("abc 123", "Basic Latin")   = true
+//  stringutils.IsAllBlock("abc 123", "basic_latin")   = true
+//  stringutils.IsAllBlock("café", "Basic Latin")      = false
+//  stringutils.IsAllBlock("\u0430\u0431", "Cyrillic") = true
+//  stringutils.IsAllBlock("abc", "No Such Block")     = false
+func IsAllBlock(s, block string) bool {
+	b, ok := lookupBlock(block)
+	if !ok || IsEmpty(s) {
+		return false
+	}
+	for _, r := range s {
+		if r < b.lo || r > b.hi {
+			return false
+		}
+	}
+	return true
+}
+
+// IsAnyBlock Checks if any rune of s is in the Unicode block, named as in IsAllBlock. False if
+// there is no such block.
+//  stringutils.IsAnyBlock("", "Cyrillic")            = false
+//  stringutils.IsAnyBlock("paypal", "Cyrillic")      = false
+//  stringutils.IsAnyBlock("p\u0430ypal", "Cyrillic") = true
+func IsAnyBlock(s, block string) bool {
+	b, ok := lookupBlock(block)
+	if !ok {
+		return false
+	}
+	for _, r := range s {
+		if r >= b.lo && r <= b.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNoneBlock Checks if no rune of s is in the Unicode block, named as in IsAllBlock. True if
+// there is no such block.
+//  stringutils.IsNoneBlock("", "Cyrillic")            = true
+//  stringutils.IsNoneBlock("paypal", "Cyrillic")      = true
+//  stringutils.IsNoneBlock("p\u0430ypal", "Cyrillic") = false
+func IsNoneBlock(s, block string) bool {
+	return !IsAnyBlock(s, block)
+}
@@ -0,0 +1,110 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBlockOf(t *testing.T) {
+	tests := []struct {
+		name string
+		r    rune
+		want string
+	}{
+		{"nul", 0, "Basic Latin"},
+		{"a", 'a', "Basic Latin"},
+		{"del", 0x7F, "Basic Latin"},
+		{"e acute", 'é', "Latin-1 Supplement"},
+		{"cyrillic a", '\u0430', "Cyrillic"},
+		{"han", '漢', "CJK Unified Ideographs"},
+		{"emoji", '\U0001F600', "Emoticons"},
+		{"last", 0x10FFFF, "Supplementary Private Use Area-B"},
+		{"syriac supplement", 0x0860, "Syriac Supplement"},
+		{"unallocated", 0xE0080, ""},
+		{"negative", -1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BlockOf(tt.r); got != tt.want {
+				t.Errorf("BlockOf(%U) = %q, want %q", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want map[string]int
+	}{
+		{"empty", "", map[string]int{}},
+		{"ascii", "ab 1", map[string]int{"Basic Latin": 4}},
+		{"latin-1", "café", map[string]int{"Basic Latin": 3, "Latin-1 Supplement": 1}},
+		{"cyrillic", "p\u0430ypal", map[string]int{"Basic Latin": 5, "Cyrillic": 1}},
+		{"unallocated", "a\U000E0080", map[string]int{"Basic Latin": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Blocks(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Blocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllBlock(t *testing.T) {
+	type args struct {
+		s     string
+		block string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", "Basic Latin"}, false},
+		{"ascii", args{"abc 123", "Basic Latin"}, true},
+		{"loose name", args{"abc 123", "basic_latin"}, true},
+		{"loose hyphen", args{"é", "latin 1 supplement"}, true},
+		{"latin-1", args{"café", "Basic Latin"}, false},
+		{"cyrillic", args{"\u0430\u0431", "Cyrillic"}, true},
+		{"unknown block", args{"abc", "No Such Block"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAllBlock(tt.args.s, tt.args.block); got != tt.want {
+				t.Errorf("IsAllBlock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyBlock(t *testing.T) {
+	type args struct {
+		s     string
+		block string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", "Cyrillic"}, false},
+		{"latin", args{"paypal", "Cyrillic"}, false},
+		{"mixed", args{"p\u0430ypal", "Cyrillic"}, true},
+		{"loose name", args{"p\u0430ypal", "CYRILLIC"}, true},
+		{"emoji", args{"hi \U0001F600", "emoticons"}, true},
+		{"unknown block", args{"abc", "No Such Block"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAnyBlock(tt.args.s, tt.args.block); got != tt.want {
+				t.Errorf("IsAnyBlock() = %v, want %v", got, tt.want)
+			}
+			if got := IsNoneBlock(tt.args.s, tt.args.block); got == tt.want {
+				t.Errorf("IsNoneBlock() = %v, want %v", got, !tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package stringutils
+
+import (
+	_ "embed" // special casing rules
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed data/SpecialCasing.txt
+var specialCasingTxt string
+
+// specialCase is one entry of SpecialCasing.txt.
+type specialCase struct {
+	lang    string
+	context string
+	lower   []rune
+	upper   []rune
+}
+
+var (
+	specialCasingOnce sync.Once
+	specialCasing     map[rune][]specialCase
+)
+
+// loadSpecialCasing parses the embedded SpecialCasing.txt, lines have the form
+//  <code>; <lower>; <title>; <upper>; (<condition_list>;)? # <comment>
+func loadSpecialCasing() {
+	specialCasing = make(map[rune][]specialCase)
+	for _, line := range strings.Split(specialCasingTxt, "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if IsBlank(line) {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 5 {
+			continue
+		}
+		code := parseCodePoints(fields[0])
+		if len(code) != 1 {
+			continue
+		}
+		sc := specialCase{lower: parseCodePoints(fields[1]), upper: parseCodePoints(fields[3])}
+		if len(fields) > 5 {
+			for _, cond := range strings.Fields(fields[4]) {
+				if unicode.IsLower(rune(cond[0])) {
+					sc.lang = cond
+				} else {
+					sc.context = cond
+				}
+			}
+		}
+		specialCasing[code[0]] = append(specialCasing[code[0]], sc)
+	}
+}
+
+// parseCodePoints parses a space separated list of hex code points, as used by the UCD files.
+func parseCodePoints(s string) []rune {
+	fields := strings.Fields(s)
+	rs := make([]rune, 0, len(fields))
+	for _, f := range fields {
+		cp, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			return nil
+		}
+		rs = append(rs, rune(cp))
+	}
+	return rs
+}
+
+// primaryLanguage returns the lower-cased primary language subtag of a BCP 47 tag.
+func primaryLanguage(tag string) string {
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return strings.ToLower(tag)
+}
+
+// ToUpperLocale Returns s with all letters mapped to their upper case using the full case mapping
+// for the language tag. Language-independent special casing (e.g. "ß" -> "SS") always applies,
+// the Turkish/Azeri dotted i, Lithuanian dot above and Greek accent removal depend on the tag.
+//  stringutils.ToUpperLocale("", "tr")          = ""
+//  stringutils.ToUpperLocale("istanbul", "")    = "ISTANBUL"
+//  stringutils.ToUpperLocale("istanbul", "tr")  = "İSTANBUL"
+//  stringutils.ToUpperLocale("straße", "de")    = "STRASSE"
+//  stringutils.ToUpperLocale("άδεια", "el")     = "ΑΔΕΙΑ"
+func ToUpperLocale(s string, tag string) string {
+	lang := primaryLanguage(tag)
+	if lang == "el" {
+		s = stripGreekAccents(s)
+	}
+	return mapCase(s, lang, true)
+}
+
+// ToLowerLocale Returns s with all letters mapped to their lower case using the full case mapping
+// for the language tag. Greek capital sigma becomes final sigma at the end of a word.
+//  stringutils.ToLowerLocale("", "tr")          = ""
+//  stringutils.ToLowerLocale("TITLE", "")       = "title"
+//  stringutils.ToLowerLocale("TITLE", "tr")     = "tıtle"
+//  stringutils.ToLowerLocale("İ", "tr")         = "i"
+//  stringutils.ToLowerLocale("ΟΔΟΣ", "el")      = "οδος"
+func ToLowerLocale(s string, tag string) string {
+	return mapCase(s, primaryLanguage(tag), false)
+}
+
+// EqualFoldLocale Checks if a and b are equal under the case rules of the language tag.
+//  stringutils.EqualFoldLocale("", "", "tr")           = true
+//  stringutils.EqualFoldLocale("TITLE", "title", "")   = true
+//  stringutils.EqualFoldLocale("TITLE", "title", "tr") = false
+//  stringutils.EqualFoldLocale("TITLE", "tıtle", "tr") = true
+//  stringutils.EqualFoldLocale("Straße", "STRASSE", "") = true
+func EqualFoldLocale(a, b string, tag string) bool {
+	if a == b {
+		return true
+	}
+	lang := primaryLanguage(tag)
+	return mapCase(mapCase(a, lang, true), lang, false) == mapCase(mapCase(b, lang, true), lang, false)
+}
+
+// mapCase applies the full upper or lower case mapping for the language to s.
+func mapCase(s string, lang string, upper bool) string {
+	specialCasingOnce.Do(loadSpecialCasing)
+	rs := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	for i, r := range rs {
+		if sc := lookupSpecialCase(rs, i, lang); sc != nil {
+			m := sc.lower
+			if upper {
+				m = sc.upper
+			}
+			for _, r := range m {
+				b.WriteRune(r)
+			}
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+		} else {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// lookupSpecialCase returns the special casing entry for rs[i], entries for the language
+// take precedence over language-independent ones, nil means the simple mapping applies.
+func lookupSpecialCase(rs []rune, i int, lang string) *specialCase {
+	entries := specialCasing[rs[i]]
+	var general *specialCase
+	for k := range entries {
+		sc := &entries[k]
+		if sc.lang != "" && sc.lang != lang {
+			continue
+		}
+		if !isCaseContext(rs, i, sc.context) {
+			continue
+		}
+		if sc.lang != "" {
+			return sc
+		}
+		if general == nil {
+			general = sc
+		}
+	}
+	return general
+}
+
+// isCaseContext evaluates the casing contexts of Unicode chapter 3.13, table 3-17.
+func isCaseContext(rs []rune, i int, context string) bool {
+	switch context {
+	case "":
+		return true
+	case "Final_Sigma":
+		return isFinalSigma(rs, i)
+	case "After_Soft_Dotted":
+		for j := i - 1; j >= 0; j-- {
+			if unicode.Is(unicode.Soft_Dotted, rs[j]) {
+				return true
+			}
+			if c := combiningClass(rs[j]); c == 0 || c == 230 {
+				return false
+			}
+		}
+		return false
+	case "More_Above":
+		for j := i + 1; j < len(rs); j++ {
+			switch combiningClass(rs[j]) {
+			case 230:
+				return true
+			case 0:
+				return false
+			}
+		}
+		return false
+	case "Before_Dot":
+		return isBeforeDot(rs, i)
+	case "Not_Before_Dot":
+		return !isBeforeDot(rs, i)
+	case "After_I":
+		for j := i - 1; j >= 0; j-- {
+			if rs[j] == 'I' {
+				return true
+			}
+			if c := combiningClass(rs[j]); c == 0 || c == 230 {
+				return false
+			}
+		}
+		return false
+	}
+	return false
+}
+
+func isFinalSigma(rs []rune, i int) bool {
+	j := i - 1
+	for j >= 0 && isCaseIgnorable(rs[j]) {
+		j--
+	}
+	if j < 0 || !isCased(rs[j]) {
+		return false
+	}
+	j = i + 1
+	for j < len(rs) && isCaseIgnorable(rs[j]) {
+		j++
+	}
+	return j == len(rs) || !isCased(rs[j])
+}
+
+func isBeforeDot(rs []rune, i int) bool {
+	for j := i + 1; j < len(rs); j++ {
+		if rs[j] == '\u0307' {
+			return true
+		}
+		if c := combiningClass(rs[j]); c == 0 || c == 230 {
+			return false
+		}
+	}
+	return false
+}
+
+// isCased reports whether the rune has the Cased property.
+func isCased(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsLower(r) || unicode.IsTitle(r) ||
+		unicode.In(r, unicode.Other_Lowercase, unicode.Other_Uppercase)
+}
+
+// isCaseIgnorable reports whether the rune has the Case_Ignorable property.
+func isCaseIgnorable(r rune) bool {
+	switch r {
+	case '\'', '.', ':', '^', '`', '\u00B7', '\u0387', '\u055F', '\u05F4', '\u2018', '\u2019',
+		'\u2024', '\u2027', '\uFE13', '\uFE52', '\uFE55', '\uFF07', '\uFF0E', '\uFF1A':
+		return true
+	}
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Lm, unicode.Sk)
+}
+
+// greekTonos maps the monotonic Greek vowels with tonos to their unaccented capital.
+var greekTonos = map[rune]rune{
+	'Ά': 'Α', 'Έ': 'Ε', 'Ή': 'Η', 'Ί': 'Ι',
+	'Ό': 'Ο', 'Ύ': 'Υ', 'Ώ': 'Ω', 'ΐ': 'Ϊ',
+	'ά': 'Α', 'έ': 'Ε', 'ή': 'Η', 'ί': 'Ι',
+	'ΰ': 'Ϋ', 'ό': 'Ο', 'ύ': 'Υ', 'ώ': 'Ω',
+}
+
+// stripGreekAccents removes the tonos from Greek vowels, as Greek upper case is written without it.
+func stripGreekAccents(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	greek := false
+	for _, r := range s {
+		if c, ok := greekTonos[r]; ok {
+			b.WriteRune(c)
+			greek = true
+			continue
+		}
+		switch r {
+		case '\u0300', '\u0301', '\u0342':
+			if greek {
+				continue
+			}
+		}
+		if combiningClass(r) == 0 {
+			greek = unicode.Is(unicode.Greek, r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
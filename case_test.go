@@ -0,0 +1,98 @@
+package stringutils
+
+import "testing"
+
+func TestToUpperLocale(t *testing.T) {
+	type args struct {
+		s   string
+		tag string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "tr"}, ""},
+		{"root", args{"istanbul", ""}, "ISTANBUL"},
+		{"tr", args{"istanbul", "tr"}, "İSTANBUL"},
+		{"tr-TR", args{"ıi", "tr-TR"}, "Iİ"},
+		{"az", args{"i", "az"}, "İ"},
+		{"sharp s", args{"straße", "de"}, "STRASSE"},
+		{"ligature", args{"ﬁle", ""}, "FILE"},
+		{"el tonos", args{"άδεια", "el"}, "ΑΔΕΙΑ"},
+		{"el combining", args{"\u03B1\u0301", "el"}, "Α"},
+		{"el dialytika", args{"ΐ", "el"}, "Ϊ"},
+		{"root tonos", args{"ά", ""}, "Ά"},
+		{"lt dot above", args{"i\u0307", "lt"}, "I"},
+		{"root dot above", args{"i\u0307", ""}, "I\u0307"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToUpperLocale(tt.args.s, tt.args.tag); got != tt.want {
+				t.Errorf("ToUpperLocale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToLowerLocale(t *testing.T) {
+	type args struct {
+		s   string
+		tag string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "tr"}, ""},
+		{"root", args{"TITLE", ""}, "title"},
+		{"tr", args{"TITLE", "tr"}, "tıtle"},
+		{"tr dotted", args{"İ", "tr"}, "i"},
+		{"tr I dot", args{"I\u0307", "tr"}, "i"},
+		{"az", args{"I", "AZ"}, "ı"},
+		{"root dotted", args{"\u0130", ""}, "i\u0307"},
+		{"final sigma", args{"ΟΔΟΣ", "el"}, "οδος"},
+		{"final sigma punct", args{"ΟΔΟΣ.", ""}, "οδος."},
+		{"sigma middle", args{"ΣΑΣ ΣΑΣ", ""}, "σας σας"},
+		{"lone sigma", args{"Σ", ""}, "σ"},
+		{"lt more above", args{"I\u0301", "lt"}, "i\u0307\u0301"},
+		{"lt grave", args{"\u00CC", "lt"}, "i\u0307\u0300"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToLowerLocale(tt.args.s, tt.args.tag); got != tt.want {
+				t.Errorf("ToLowerLocale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualFoldLocale(t *testing.T) {
+	type args struct {
+		a   string
+		b   string
+		tag string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", "", "tr"}, true},
+		{"root", args{"TITLE", "title", ""}, true},
+		{"tr i", args{"TITLE", "title", "tr"}, false},
+		{"tr dotless", args{"TITLE", "tıtle", "tr"}, true},
+		{"tr dotted", args{"İstanbul", "istanbul", "tr"}, true},
+		{"sharp s", args{"Straße", "STRASSE", ""}, true},
+		{"sigma", args{"σας", "ΣΑΣ", "el"}, true},
+		{"different", args{"abc", "abd", ""}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualFoldLocale(tt.args.a, tt.args.b, tt.args.tag); got != tt.want {
+				t.Errorf("EqualFoldLocale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
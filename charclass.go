@@ -0,0 +1,465 @@
+package stringutils
+
+import "unicode"
+
+// isEvery reports whether f holds for every rune of s, true for an empty string.
+func isEvery(s string, f func(rune) bool) bool {
+	for _, r := range s {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isLetterOrSpace(r rune) bool {
+	return unicode.IsLetter(r) || r == ' '
+}
+
+func isDigitOrSpace(r rune) bool {
+	return unicode.IsDigit(r) || r == ' '
+}
+
+func isLetterOrDigit(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isLetterOrDigitOrSpace(r rune) bool {
+	return isLetterOrDigit(r) || r == ' '
+}
+
+func isASCIIPrintable(r rune) bool {
+	return r >= ' ' && r <= '~'
+}
+
+// IsAlpha Checks if s contains only Unicode letters, an empty string is not alphabetic.
+//  stringutils.IsAlpha("")     = false
+//  stringutils.IsAlpha("  ")   = false
+//  stringutils.IsAlpha("abc")  = true
+//  stringutils.IsAlpha("ab2c") = false
+//  stringutils.IsAlpha("ab-c") = false
+//  stringutils.IsAlpha("äbç")  = true
+func IsAlpha(s string) bool {
+	return IsNotEmpty(s) && isEvery(s, unicode.IsLetter)
+}
+
+// IsAlphaSpace Checks if s contains only Unicode letters and spaces (' '), an empty string is.
+//  stringutils.IsAlphaSpace("")     = true
+//  stringutils.IsAlphaSpace("  ")   = true
+//  stringutils.IsAlphaSpace("abc")  = true
+//  stringutils.IsAlphaSpace("ab c") = true
+//  stringutils.IsAlphaSpace("ab2c") = false
+//  stringutils.IsAlphaSpace("ab-c") = false
+func IsAlphaSpace(s string) bool {
+	return isEvery(s, isLetterOrSpace)
+}
+
+// IsNumeric Checks if s contains only Unicode digits, an empty string is not numeric. Signs and
+// decimal points are not digits.
+//  stringutils.IsNumeric("")     = false
+//  stringutils.IsNumeric("  ")   = false
+//  stringutils.IsNumeric("123")  = true
+//  stringutils.IsNumeric("१२३")  = true
+//  stringutils.IsNumeric("12 3") = false
+//  stringutils.IsNumeric("-123") = false
+//  stringutils.IsNumeric("12.3") = false
+func IsNumeric(s string) bool {
+	return IsNotEmpty(s) && isEvery(s, unicode.IsDigit)
+}
+
+// IsNumericSpace Checks if s contains only Unicode digits and spaces (' '), an empty string is.
+//  stringutils.IsNumericSpace("")     = true
+//  stringutils.IsNumericSpace("  ")   = true
+//  stringutils.IsNumericSpace("123")  = true
+//  stringutils.IsNumericSpace("12 3") = true
+//  stringutils.IsNumericSpace("ab2c") = false
+//  stringutils.IsNumericSpace("12.3") = false
+func IsNumericSpace(s string) bool {
+	return isEvery(s, isDigitOrSpace)
+}
+
+// IsAlphanumeric Checks if s contains only Unicode letters and digits, an empty string is not alphanumeric.
+//  stringutils.IsAlphanumeric("")     = false
+//  stringutils.IsAlphanumeric("  ")   = false
+//  stringutils.IsAlphanumeric("abc")  = true
+//  stringutils.IsAlphanumeric("ab c") = false
+//  stringutils.IsAlphanumeric("ab2c") = true
+//  stringutils.IsAlphanumeric("ab-c") = false
+func IsAlphanumeric(s string) bool {
+	return IsNotEmpty(s) && isEvery(s, isLetterOrDigit)
+}
+
+// IsAlphanumericSpace Checks if s contains only Unicode letters, digits and spaces (' '), an empty string is.
+//  stringutils.IsAlphanumericSpace("")     = true
+//  stringutils.IsAlphanumericSpace("  ")   = true
+//  stringutils.IsAlphanumericSpace("abc")  = true
+//  stringutils.IsAlphanumericSpace("ab c") = true
+//  stringutils.IsAlphanumericSpace("ab2c") = true
+//  stringutils.IsAlphanumericSpace("ab-c") = false
+func IsAlphanumericSpace(s string) bool {
+	return isEvery(s, isLetterOrDigitOrSpace)
+}
+
+// IsASCIIPrintable Checks if s contains only printable ASCII characters, from ' ' to '~', an empty string is.
+//  stringutils.IsASCIIPrintable("")           = true
+//  stringutils.IsASCIIPrintable(" ")          = true
+//  stringutils.IsASCIIPrintable("Ceki")       = true
+//  stringutils.IsASCIIPrintable("ab2c")       = true
+//  stringutils.IsASCIIPrintable("!ab-c~")     = true
+//  stringutils.IsASCIIPrintable("\u007f")     = false
+//  stringutils.IsASCIIPrintable("Ceki Gülcü") = false
+func IsASCIIPrintable(s string) bool {
+	return isEvery(s, isASCIIPrintable)
+}
+
+// IsAllLowerCase Checks if s contains only lower case letters, an empty string does not. Its name
+// already starts with IsAll, so unlike the other checks it has no All/Any/None variants for many strings.
+//  stringutils.IsAllLowerCase("")     = false
+//  stringutils.IsAllLowerCase("  ")   = false
+//  stringutils.IsAllLowerCase("abc")  = true
+//  stringutils.IsAllLowerCase("abC")  = false
+//  stringutils.IsAllLowerCase("ab c") = false
+//  stringutils.IsAllLowerCase("ab1c") = false
+//  stringutils.IsAllLowerCase("ab/c") = false
+func IsAllLowerCase(s string) bool {
+	return IsNotEmpty(s) && isEvery(s, unicode.IsLower)
+}
+
+// IsAllUpperCase Checks if s contains only upper case letters, an empty string does not. Like
+// IsAllLowerCase it has no All/Any/None variants for many strings.
+//  stringutils.IsAllUpperCase("")    = false
+//  stringutils.IsAllUpperCase("  ")  = false
+//  stringutils.IsAllUpperCase("ABC") = true
+//  stringutils.IsAllUpperCase("aBC") = false
+//  stringutils.IsAllUpperCase("A C") = false
+//  stringutils.IsAllUpperCase("A1C") = false
+//  stringutils.IsAllUpperCase("A/C") = false
+func IsAllUpperCase(s string) bool {
+	return IsNotEmpty(s) && isEvery(s, unicode.IsUpper)
+}
+
+// IsMixedCase Checks if s contains both upper and lower case letters, other characters are ignored.
+//  stringutils.IsMixedCase("")    = false
+//  stringutils.IsMixedCase(" ")   = false
+//  stringutils.IsMixedCase("ABC") = false
+//  stringutils.IsMixedCase("abc") = false
+//  stringutils.IsMixedCase("aBc") = true
+//  stringutils.IsMixedCase("A c") = true
+//  stringutils.IsMixedCase("B1c") = true
+//  stringutils.IsMixedCase("a/C") = true
+//  stringutils.IsMixedCase("cA.") = true
+func IsMixedCase(s string) bool {
+	upper, lower := false, false
+	for _, r := range s {
+		upper = upper || unicode.IsUpper(r)
+		lower = lower || unicode.IsLower(r)
+		if upper && lower {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWhitespace Checks if s contains only whitespace, the same characters as IsBlank, an empty string does.
+//  stringutils.IsWhitespace("")     = true
+//  stringutils.IsWhitespace("  ")   = true
+//  stringutils.IsWhitespace("\t\n") = true
+//  stringutils.IsWhitespace("abc")  = false
+//  stringutils.IsWhitespace("ab2c") = false
+//  stringutils.IsWhitespace("ab-c") = false
+func IsWhitespace(s string) bool {
+	return IsBlank(s)
+}
+
+// isAllStrings reports whether f holds for all the strings, an error if there are none.
+func isAllStrings(ss []string, f func(string) bool) (bool, error) {
+	if len(ss) == 0 {
+		return true, ErrNoArguments
+	}
+	for _, s := range ss {
+		if !f(s) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// isAnyString reports whether f holds for any of the strings, an error if there are none.
+func isAnyString(ss []string, f func(string) bool) (bool, error) {
+	if len(ss) == 0 {
+		return true, ErrNoArguments
+	}
+	for _, s := range ss {
+		if f(s) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsAllAlpha Checks if all the strings contain only Unicode letters, see IsAlpha.
+//  stringutils.IsAllAlpha()             = true, error
+//  stringutils.IsAllAlpha("")           = false
+//  stringutils.IsAllAlpha("abc", "cba") = true
+//  stringutils.IsAllAlpha("abc", "c1a") = false
+func IsAllAlpha(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsAlpha)
+}
+
+// IsAnyAlpha Checks if any of the strings contains only Unicode letters, see IsAlpha.
+//  stringutils.IsAnyAlpha()             = true, error
+//  stringutils.IsAnyAlpha("")           = false
+//  stringutils.IsAnyAlpha("123", "cba") = true
+//  stringutils.IsAnyAlpha("123", "c1a") = false
+func IsAnyAlpha(ss ...string) (bool, error) {
+	return isAnyString(ss, IsAlpha)
+}
+
+// IsNoneAlpha Checks if none of the strings contains only Unicode letters, see IsAlpha.
+//  stringutils.IsNoneAlpha()             = false, error
+//  stringutils.IsNoneAlpha("")           = true
+//  stringutils.IsNoneAlpha("123", "cba") = false
+//  stringutils.IsNoneAlpha("123", "c1a") = true
+func IsNoneAlpha(ss ...string) (b bool, e error) {
+	b, e = IsAnyAlpha(ss...)
+	return !b, e
+}
+
+// IsAllNumeric Checks if all the strings contain only Unicode digits, see IsNumeric.
+//  stringutils.IsAllNumeric()             = true, error
+//  stringutils.IsAllNumeric("")           = false
+//  stringutils.IsAllNumeric("123", "321") = true
+//  stringutils.IsAllNumeric("123", "3.1") = false
+func IsAllNumeric(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsNumeric)
+}
+
+// IsAnyNumeric Checks if any of the strings contains only Unicode digits, see IsNumeric.
+//  stringutils.IsAnyNumeric()             = true, error
+//  stringutils.IsAnyNumeric("")           = false
+//  stringutils.IsAnyNumeric("abc", "321") = true
+//  stringutils.IsAnyNumeric("abc", "3.1") = false
+func IsAnyNumeric(ss ...string) (bool, error) {
+	return isAnyString(ss, IsNumeric)
+}
+
+// IsNoneNumeric Checks if none of the strings contains only Unicode digits, see IsNumeric.
+//  stringutils.IsNoneNumeric()             = false, error
+//  stringutils.IsNoneNumeric("")           = true
+//  stringutils.IsNoneNumeric("abc", "321") = false
+//  stringutils.IsNoneNumeric("abc", "3.1") = true
+func IsNoneNumeric(ss ...string) (b bool, e error) {
+	b, e = IsAnyNumeric(ss...)
+	return !b, e
+}
+
+// IsAllAlphanumeric Checks if all the strings contain only Unicode letters and digits, see IsAlphanumeric.
+//  stringutils.IsAllAlphanumeric()             = true, error
+//  stringutils.IsAllAlphanumeric("")           = false
+//  stringutils.IsAllAlphanumeric("ab1", "c2a") = true
+//  stringutils.IsAllAlphanumeric("ab1", "c a") = false
+func IsAllAlphanumeric(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsAlphanumeric)
+}
+
+// IsAnyAlphanumeric Checks if any of the strings contains only Unicode letters and digits, see IsAlphanumeric.
+//  stringutils.IsAnyAlphanumeric()             = true, error
+//  stringutils.IsAnyAlphanumeric("")           = false
+//  stringutils.IsAnyAlphanumeric("a-1", "c2a") = true
+//  stringutils.IsAnyAlphanumeric("a-1", "c a") = false
+func IsAnyAlphanumeric(ss ...string) (bool, error) {
+	return isAnyString(ss, IsAlphanumeric)
+}
+
+// IsNoneAlphanumeric Checks if none of the strings contains only Unicode letters and digits, see IsAlphanumeric.
+//  stringutils.IsNoneAlphanumeric()             = false, error
+//  stringutils.IsNoneAlphanumeric("")           = true
+//  stringutils.IsNoneAlphanumeric("a-1", "c2a") = false
+//  stringutils.IsNoneAlphanumeric("a-1", "c a") = true
+func IsNoneAlphanumeric(ss ...string) (b bool, e error) {
+	b, e = IsAnyAlphanumeric(ss...)
+	return !b, e
+}
+
+// IsAllASCIIPrintable Checks if all the strings contain only printable ASCII characters, see IsASCIIPrintable.
+//  stringutils.IsAllASCIIPrintable()            = true, error
+//  stringutils.IsAllASCIIPrintable("")          = true
+//  stringutils.IsAllASCIIPrintable("a b", "c~") = true
+//  stringutils.IsAllASCIIPrintable("a b", "ç")  = false
+func IsAllASCIIPrintable(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsASCIIPrintable)
+}
+
+// IsAnyASCIIPrintable Checks if any of the strings contains only printable ASCII characters, see IsASCIIPrintable.
+//  stringutils.IsAnyASCIIPrintable()           = true, error
+//  stringutils.IsAnyASCIIPrintable("")         = true
+//  stringutils.IsAnyASCIIPrintable("\t", "c~") = true
+//  stringutils.IsAnyASCIIPrintable("\t", "ç")  = false
+func IsAnyASCIIPrintable(ss ...string) (bool, error) {
+	return isAnyString(ss, IsASCIIPrintable)
+}
+
+// IsNoneASCIIPrintable Checks if none of the strings contains only printable ASCII characters, see IsASCIIPrintable.
+//  stringutils.IsNoneASCIIPrintable()           = false, error
+//  stringutils.IsNoneASCIIPrintable("")         = false
+//  stringutils.IsNoneASCIIPrintable("\t", "c~") = false
+//  stringutils.IsNoneASCIIPrintable("\t", "ç")  = true
+func IsNoneASCIIPrintable(ss ...string) (b bool, e error) {
+	b, e = IsAnyASCIIPrintable(ss...)
+	return !b, e
+}
+
+// IsAllAlphaSpace Checks if all the strings contain only Unicode letters and spaces, see IsAlphaSpace.
+//  stringutils.IsAllAlphaSpace()             = true, error
+//  stringutils.IsAllAlphaSpace("")           = true
+//  stringutils.IsAllAlphaSpace("a b", "cba") = true
+//  stringutils.IsAllAlphaSpace("a b", "c1a") = false
+//  stringutils.IsAllAlphaSpace("123", "c-a") = false
+func IsAllAlphaSpace(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsAlphaSpace)
+}
+
+// IsAnyAlphaSpace Checks if any of the strings contains only Unicode letters and spaces, see IsAlphaSpace.
+//  stringutils.IsAnyAlphaSpace()             = true, error
+//  stringutils.IsAnyAlphaSpace("")           = true
+//  stringutils.IsAnyAlphaSpace("a b", "cba") = true
+//  stringutils.IsAnyAlphaSpace("a b", "c1a") = true
+//  stringutils.IsAnyAlphaSpace("123", "c-a") = false
+func IsAnyAlphaSpace(ss ...string) (bool, error) {
+	return isAnyString(ss, IsAlphaSpace)
+}
+
+// IsNoneAlphaSpace Checks if none of the strings contains only Unicode letters and spaces, see IsAlphaSpace.
+//  stringutils.IsNoneAlphaSpace()             = false, error
+//  stringutils.IsNoneAlphaSpace("")           = false
+//  stringutils.IsNoneAlphaSpace("a b", "cba") = false
+//  stringutils.IsNoneAlphaSpace("a b", "c1a") = false
+//  stringutils.IsNoneAlphaSpace("123", "c-a") = true
+func IsNoneAlphaSpace(ss ...string) (b bool, e error) {
+	b, e = IsAnyAlphaSpace(ss...)
+	return !b, e
+}
+
+// IsAllNumericSpace Checks if all the strings contain only Unicode digits and spaces, see IsNumericSpace.
+//  stringutils.IsAllNumericSpace()             = true, error
+//  stringutils.IsAllNumericSpace("")           = true
+//  stringutils.IsAllNumericSpace("1 2", "321") = true
+//  stringutils.IsAllNumericSpace("1 2", "3.1") = false
+//  stringutils.IsAllNumericSpace("abc", "3.1") = false
+func IsAllNumericSpace(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsNumericSpace)
+}
+
+// IsAnyNumericSpace Checks if any of the strings contains only Unicode digits and spaces, see IsNumericSpace.
+//  stringutils.IsAnyNumericSpace()             = true, error
+//  stringutils.IsAnyNumericSpace("")           = true
+//  stringutils.IsAnyNumericSpace("1 2", "321") = true
+//  stringutils.IsAnyNumericSpace("1 2", "3.1") = true
+//  stringutils.IsAnyNumericSpace("abc", "3.1") = false
+func IsAnyNumericSpace(ss ...string) (bool, error) {
+	return isAnyString(ss, IsNumericSpace)
+}
+
+// IsNoneNumericSpace Checks if none of the strings contains only Unicode digits and spaces, see IsNumericSpace.
+//  stringutils.IsNoneNumericSpace()             = false, error
+//  stringutils.IsNoneNumericSpace("")           = false
+//  stringutils.IsNoneNumericSpace("1 2", "321") = false
+//  stringutils.IsNoneNumericSpace("1 2", "3.1") = false
+//  stringutils.IsNoneNumericSpace("abc", "3.1") = true
+func IsNoneNumericSpace(ss ...string) (b bool, e error) {
+	b, e = IsAnyNumericSpace(ss...)
+	return !b, e
+}
+
+// IsAllAlphanumericSpace Checks if all the strings contain only Unicode letters, digits and spaces, see IsAlphanumericSpace.
+//  stringutils.IsAllAlphanumericSpace()             = true, error
+//  stringutils.IsAllAlphanumericSpace("")           = true
+//  stringutils.IsAllAlphanumericSpace("a 1", "c2a") = true
+//  stringutils.IsAllAlphanumericSpace("a 1", "c-a") = false
+//  stringutils.IsAllAlphanumericSpace("a-1", "c-a") = false
+func IsAllAlphanumericSpace(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsAlphanumericSpace)
+}
+
+// IsAnyAlphanumericSpace Checks if any of the strings contains only Unicode letters, digits and spaces, see IsAlphanumericSpace.
+//  stringutils.IsAnyAlphanumericSpace()             = true, error
+//  stringutils.IsAnyAlphanumericSpace("")           = true
+//  stringutils.IsAnyAlphanumericSpace("a 1", "c2a") = true
+//  stringutils.IsAnyAlphanumericSpace("a 1", "c-a") = true
+//  stringutils.IsAnyAlphanumericSpace("a-1", "c-a") = false
+func IsAnyAlphanumericSpace(ss ...string) (bool, error) {
+	return isAnyString(ss, IsAlphanumericSpace)
+}
+
+// IsNoneAlphanumericSpace Checks if none of the strings contains only Unicode letters, digits and spaces, see IsAlphanumericSpace.
+//  stringutils.IsNoneAlphanumericSpace()             = false, error
+//  stringutils.IsNoneAlphanumericSpace("")           = false
+//  stringutils.IsNoneAlphanumericSpace("a 1", "c2a") = false
+//  stringutils.IsNoneAlphanumericSpace("a 1", "c-a") = false
+//  stringutils.IsNoneAlphanumericSpace("a-1", "c-a") = true
+func IsNoneAlphanumericSpace(ss ...string) (b bool, e error) {
+	b, e = IsAnyAlphanumericSpace(ss...)
+	return !b, e
+}
+
+// IsAllWhitespace Checks if all the strings contain only whitespace, see IsWhitespace.
+//  stringutils.IsAllWhitespace()             = true, error
+//  stringutils.IsAllWhitespace("")           = true
+//  stringutils.IsAllWhitespace(" ", "\t")    = true
+//  stringutils.IsAllWhitespace(" ", "abc")   = false
+//  stringutils.IsAllWhitespace("abc", " a ") = false
+func IsAllWhitespace(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsWhitespace)
+}
+
+// IsAnyWhitespace Checks if any of the strings contains only whitespace, see IsWhitespace.
+//  stringutils.IsAnyWhitespace()             = true, error
+//  stringutils.IsAnyWhitespace("")           = true
+//  stringutils.IsAnyWhitespace(" ", "\t")    = true
+//  stringutils.IsAnyWhitespace(" ", "abc")   = true
+//  stringutils.IsAnyWhitespace("abc", " a ") = false
+func IsAnyWhitespace(ss ...string) (bool, error) {
+	return isAnyString(ss, IsWhitespace)
+}
+
+// IsNoneWhitespace Checks if none of the strings contains only whitespace, see IsWhitespace.
+//  stringutils.IsNoneWhitespace()             = false, error
+//  stringutils.IsNoneWhitespace("")           = false
+//  stringutils.IsNoneWhitespace(" ", "\t")    = false
+//  stringutils.IsNoneWhitespace(" ", "abc")   = false
+//  stringutils.IsNoneWhitespace("abc", " a ") = true
+func IsNoneWhitespace(ss ...string) (b bool, e error) {
+	b, e = IsAnyWhitespace(ss...)
+	return !b, e
+}
+
+// IsAllMixedCase Checks if all the strings contain both upper and lower case letters, see IsMixedCase.
+//  stringutils.IsAllMixedCase()           = true, error
+//  stringutils.IsAllMixedCase("")         = false
+//  stringutils.IsAllMixedCase("aB", "Ab") = true
+//  stringutils.IsAllMixedCase("aB", "ab") = false
+//  stringutils.IsAllMixedCase("AB", "ab") = false
+func IsAllMixedCase(ss ...string) (bool, error) {
+	return isAllStrings(ss, IsMixedCase)
+}
+
+// IsAnyMixedCase Checks if any of the strings contains both upper and lower case letters, see IsMixedCase.
+//  stringutils.IsAnyMixedCase()           = true, error
+//  stringutils.IsAnyMixedCase("")         = false
+//  stringutils.IsAnyMixedCase("aB", "Ab") = true
+//  stringutils.IsAnyMixedCase("aB", "ab") = true
+//  stringutils.IsAnyMixedCase("AB", "ab") = false
+func IsAnyMixedCase(ss ...string) (bool, error) {
+	return isAnyString(ss, IsMixedCase)
+}
+
+// IsNoneMixedCase Checks if none of the strings contains both upper and lower case letters, see IsMixedCase.
+//  stringutils.IsNoneMixedCase()           = false, error
+//  stringutils.IsNoneMixedCase("")         = true
+//  stringutils.IsNoneMixedCase("aB", "Ab") = false
+//  stringutils.IsNoneMixedCase("aB", "ab") = false
+//  stringutils.IsNoneMixedCase("AB", "ab") = true
+func IsNoneMixedCase(ss ...string) (b bool, e error) {
+	b, e = IsAnyMixedCase(ss...)
+	return !b, e
+}
@@ -0,0 +1,1100 @@
+package stringutils
+
+import "testing"
+
+func TestIsAlpha(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"spaces", args{"  "}, false},
+		{"abc", args{"abc"}, true},
+		{"ab c", args{"ab c"}, false},
+		{"ab2c", args{"ab2c"}, false},
+		{"ab-c", args{"ab-c"}, false},
+		{"accents", args{"äbç"}, true},
+		{"cyrillic", args{"мир"}, true},
+		{"combining mark", args{"e\u0301"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAlpha(tt.args.s); got != tt.want {
+				t.Errorf("IsAlpha() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAlphaSpace(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, true},
+		{"spaces", args{"  "}, true},
+		{"abc", args{"abc"}, true},
+		{"ab c", args{"ab c"}, true},
+		{"ab\\tc", args{"ab\tc"}, false},
+		{"ab2c", args{"ab2c"}, false},
+		{"ab-c", args{"ab-c"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAlphaSpace(tt.args.s); got != tt.want {
+				t.Errorf("IsAlphaSpace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNumeric(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"spaces", args{"  "}, false},
+		{"123", args{"123"}, true},
+		{"devanagari", args{"१२३"}, true},
+		{"12 3", args{"12 3"}, false},
+		{"ab2c", args{"ab2c"}, false},
+		{"-123", args{"-123"}, false},
+		{"+123", args{"+123"}, false},
+		{"12.3", args{"12.3"}, false},
+		{"superscript", args{"²"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNumeric(tt.args.s); got != tt.want {
+				t.Errorf("IsNumeric() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNumericSpace(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, true},
+		{"spaces", args{"  "}, true},
+		{"123", args{"123"}, true},
+		{"12 3", args{"12 3"}, true},
+		{"ab2c", args{"ab2c"}, false},
+		{"12.3", args{"12.3"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNumericSpace(tt.args.s); got != tt.want {
+				t.Errorf("IsNumericSpace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAlphanumeric(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"spaces", args{"  "}, false},
+		{"abc", args{"abc"}, true},
+		{"ab c", args{"ab c"}, false},
+		{"ab2c", args{"ab2c"}, true},
+		{"ab-c", args{"ab-c"}, false},
+		{"123", args{"123"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAlphanumeric(tt.args.s); got != tt.want {
+				t.Errorf("IsAlphanumeric() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAlphanumericSpace(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, true},
+		{"spaces", args{"  "}, true},
+		{"abc", args{"abc"}, true},
+		{"ab c", args{"ab c"}, true},
+		{"ab2c", args{"ab2c"}, true},
+		{"ab-c", args{"ab-c"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAlphanumericSpace(tt.args.s); got != tt.want {
+				t.Errorf("IsAlphanumericSpace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsASCIIPrintable(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, true},
+		{"space", args{" "}, true},
+		{"Ceki", args{"Ceki"}, true},
+		{"!ab-c~", args{"!ab-c~"}, true},
+		{"\\t", args{"\t"}, false},
+		{"\\x7f", args{"\x7f"}, false},
+		{"accents", args{"Ceki Gülcü"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsASCIIPrintable(tt.args.s); got != tt.want {
+				t.Errorf("IsASCIIPrintable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllLowerCase(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"spaces", args{"  "}, false},
+		{"abc", args{"abc"}, true},
+		{"abC", args{"abC"}, false},
+		{"ab c", args{"ab c"}, false},
+		{"ab1c", args{"ab1c"}, false},
+		{"ab/c", args{"ab/c"}, false},
+		{"stra\\u00dfe", args{"straße"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAllLowerCase(tt.args.s); got != tt.want {
+				t.Errorf("IsAllLowerCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllUpperCase(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"spaces", args{"  "}, false},
+		{"ABC", args{"ABC"}, true},
+		{"aBC", args{"aBC"}, false},
+		{"A C", args{"A C"}, false},
+		{"A1C", args{"A1C"}, false},
+		{"A/C", args{"A/C"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAllUpperCase(tt.args.s); got != tt.want {
+				t.Errorf("IsAllUpperCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMixedCase(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, false},
+		{"space", args{" "}, false},
+		{"A", args{"A"}, false},
+		{"ABC", args{"ABC"}, false},
+		{"abc", args{"abc"}, false},
+		{"aBc", args{"aBc"}, true},
+		{"A c", args{"A c"}, true},
+		{"B1c", args{"B1c"}, true},
+		{"a/C", args{"a/C"}, true},
+		{"cA.", args{"cA."}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMixedCase(tt.args.s); got != tt.want {
+				t.Errorf("IsMixedCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWhitespace(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{""}, true},
+		{"spaces", args{"  "}, true},
+		{"\\t\\n\\v\\f\\r", args{"\t\n\v\f\r"}, true},
+		{"\\u00A0\\u2007", args{"\u00A0\u2007"}, true},
+		{"abc", args{"abc"}, false},
+		{" a ", args{" a "}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWhitespace(tt.args.s); got != tt.want {
+				t.Errorf("IsWhitespace() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllAlpha(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[abc,cba]", args{[]string{"abc", "cba"}}, true, false},
+		{"[abc,c1a]", args{[]string{"abc", "c1a"}}, false, false},
+		{"[abc,empty]", args{[]string{"abc", ""}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllAlpha(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllAlpha() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllAlpha() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyAlpha(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[123,cba]", args{[]string{"123", "cba"}}, true, false},
+		{"[123,c1a]", args{[]string{"123", "c1a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyAlpha(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyAlpha() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyAlpha() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneAlpha(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[123,cba]", args{[]string{"123", "cba"}}, false, false},
+		{"[123,c1a]", args{[]string{"123", "c1a"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneAlpha(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneAlpha() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneAlpha() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllNumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[123,321]", args{[]string{"123", "321"}}, true, false},
+		{"[123,3.1]", args{[]string{"123", "3.1"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllNumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllNumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllNumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyAlphanumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[a-1,c2a]", args{[]string{"a-1", "c2a"}}, true, false},
+		{"[a-1,c a]", args{[]string{"a-1", "c a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyAlphanumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyAlphanumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyAlphanumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneASCIIPrintable(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[\\t,c~]", args{[]string{"\t", "c~"}}, false, false},
+		{"[\\t,\\u00e7]", args{[]string{"\t", "ç"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneASCIIPrintable(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneASCIIPrintable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneASCIIPrintable() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyNumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[123,456]", args{[]string{"123", "456"}}, true, false},
+		{"[123,4.5]", args{[]string{"123", "4.5"}}, true, false},
+		{"[abc,4.5]", args{[]string{"abc", "4.5"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyNumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyNumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyNumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneNumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[123,456]", args{[]string{"123", "456"}}, false, false},
+		{"[123,4.5]", args{[]string{"123", "4.5"}}, false, false},
+		{"[abc,4.5]", args{[]string{"abc", "4.5"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneNumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneNumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneNumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllAlphanumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[ab1,c2]", args{[]string{"ab1", "c2"}}, true, false},
+		{"[ab1,c-2]", args{[]string{"ab1", "c-2"}}, false, false},
+		{"[a b,c-2]", args{[]string{"a b", "c-2"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllAlphanumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllAlphanumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllAlphanumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneAlphanumeric(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[ab1,c2]", args{[]string{"ab1", "c2"}}, false, false},
+		{"[ab1,c-2]", args{[]string{"ab1", "c-2"}}, false, false},
+		{"[a b,c-2]", args{[]string{"a b", "c-2"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneAlphanumeric(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneAlphanumeric() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneAlphanumeric() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllASCIIPrintable(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[ab,c~]", args{[]string{"ab", "c~"}}, true, false},
+		{"[\\t,c~]", args{[]string{"\t", "c~"}}, false, false},
+		{"[\\t,\\u00e7]", args{[]string{"\t", "ç"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllASCIIPrintable(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllASCIIPrintable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllASCIIPrintable() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyASCIIPrintable(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[ab,c~]", args{[]string{"ab", "c~"}}, true, false},
+		{"[\\t,c~]", args{[]string{"\t", "c~"}}, true, false},
+		{"[\\t,\\u00e7]", args{[]string{"\t", "ç"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyASCIIPrintable(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyASCIIPrintable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyASCIIPrintable() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllAlphaSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[a b,cba]", args{[]string{"a b", "cba"}}, true, false},
+		{"[a b,c1a]", args{[]string{"a b", "c1a"}}, false, false},
+		{"[123,c-a]", args{[]string{"123", "c-a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllAlphaSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllAlphaSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllAlphaSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyAlphaSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[a b,cba]", args{[]string{"a b", "cba"}}, true, false},
+		{"[a b,c1a]", args{[]string{"a b", "c1a"}}, true, false},
+		{"[123,c-a]", args{[]string{"123", "c-a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyAlphaSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyAlphaSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyAlphaSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneAlphaSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[a b,cba]", args{[]string{"a b", "cba"}}, false, false},
+		{"[a b,c1a]", args{[]string{"a b", "c1a"}}, false, false},
+		{"[123,c-a]", args{[]string{"123", "c-a"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneAlphaSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneAlphaSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneAlphaSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllNumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[1 2,321]", args{[]string{"1 2", "321"}}, true, false},
+		{"[1 2,3.1]", args{[]string{"1 2", "3.1"}}, false, false},
+		{"[abc,3.1]", args{[]string{"abc", "3.1"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllNumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllNumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllNumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyNumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[1 2,321]", args{[]string{"1 2", "321"}}, true, false},
+		{"[1 2,3.1]", args{[]string{"1 2", "3.1"}}, true, false},
+		{"[abc,3.1]", args{[]string{"abc", "3.1"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyNumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyNumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyNumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneNumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[1 2,321]", args{[]string{"1 2", "321"}}, false, false},
+		{"[1 2,3.1]", args{[]string{"1 2", "3.1"}}, false, false},
+		{"[abc,3.1]", args{[]string{"abc", "3.1"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneNumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneNumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneNumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllAlphanumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[a 1,c2a]", args{[]string{"a 1", "c2a"}}, true, false},
+		{"[a 1,c-a]", args{[]string{"a 1", "c-a"}}, false, false},
+		{"[a-1,c-a]", args{[]string{"a-1", "c-a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllAlphanumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllAlphanumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllAlphanumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyAlphanumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[a 1,c2a]", args{[]string{"a 1", "c2a"}}, true, false},
+		{"[a 1,c-a]", args{[]string{"a 1", "c-a"}}, true, false},
+		{"[a-1,c-a]", args{[]string{"a-1", "c-a"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyAlphanumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyAlphanumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyAlphanumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneAlphanumericSpace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[a 1,c2a]", args{[]string{"a 1", "c2a"}}, false, false},
+		{"[a 1,c-a]", args{[]string{"a 1", "c-a"}}, false, false},
+		{"[a-1,c-a]", args{[]string{"a-1", "c-a"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneAlphanumericSpace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneAlphanumericSpace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneAlphanumericSpace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllWhitespace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[ ,\\t]", args{[]string{" ", "\t"}}, true, false},
+		{"[ ,abc]", args{[]string{" ", "abc"}}, false, false},
+		{"[abc, a ]", args{[]string{"abc", " a "}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllWhitespace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllWhitespace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllWhitespace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyWhitespace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[ ,\\t]", args{[]string{" ", "\t"}}, true, false},
+		{"[ ,abc]", args{[]string{" ", "abc"}}, true, false},
+		{"[abc, a ]", args{[]string{"abc", " a "}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyWhitespace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyWhitespace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyWhitespace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneWhitespace(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[ ,\\t]", args{[]string{" ", "\t"}}, false, false},
+		{"[ ,abc]", args{[]string{" ", "abc"}}, false, false},
+		{"[abc, a ]", args{[]string{"abc", " a "}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneWhitespace(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneWhitespace() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneWhitespace() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllMixedCase(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[aB,Ab]", args{[]string{"aB", "Ab"}}, true, false},
+		{"[aB,ab]", args{[]string{"aB", "ab"}}, false, false},
+		{"[AB,ab]", args{[]string{"AB", "ab"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAllMixedCase(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAllMixedCase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAllMixedCase() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyMixedCase(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, true, true},
+		{"[empty]", args{[]string{""}}, false, false},
+		{"[aB,Ab]", args{[]string{"aB", "Ab"}}, true, false},
+		{"[aB,ab]", args{[]string{"aB", "ab"}}, true, false},
+		{"[AB,ab]", args{[]string{"AB", "ab"}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsAnyMixedCase(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsAnyMixedCase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsAnyMixedCase() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoneMixedCase(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, false, true},
+		{"[empty]", args{[]string{""}}, true, false},
+		{"[aB,Ab]", args{[]string{"aB", "Ab"}}, false, false},
+		{"[aB,ab]", args{[]string{"aB", "ab"}}, false, false},
+		{"[AB,ab]", args{[]string{"AB", "ab"}}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsNoneMixedCase(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsNoneMixedCase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsNoneMixedCase() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package stringutils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// runeRange is a range of runes from lo to hi inclusive.
+type runeRange struct {
+	lo, hi rune
+}
+
+// CharSet is a set of runes, built from a spec such as "a-z" or "^0-9" with NewCharSet or from a
+// unicode.RangeTable. The zero value is the empty set. A CharSet is not modified once built, the set
+// operations return new sets.
+type CharSet struct {
+	// ascii holds the runes below utf8.RuneSelf as bits, so that they are found without a search.
+	ascii [2]uint64
+	// ranges are the sorted, disjoint and not adjacent ranges of the set, the ASCII ones included.
+	ranges []runeRange
+}
+
+// BlankCharSet is the set of the whitespace runes of IsBlank, unicode.White_Space and the
+// information separators.
+var BlankCharSet = NewCharSetFromTable(unicode.White_Space).Union(NewCharSet("\x1c-\x1f"))
+
+// NewCharSet Returns the set of the runes of the specs, in the syntax of the Apache Commons Lang CharSet:
+// "a" is the rune itself, "a-e" the range from 'a' to 'e', "^a" all the runes but 'a' and "^a-e" all
+// the runes out of the range. A "^" or "-" which cannot start or make a range is taken as itself, so
+// "^" and "a-" are literal. The set holds a rune if any part of any spec holds it.
+//  stringutils.NewCharSet("").String()         = ""
+//  stringutils.NewCharSet("abc").String()      = "a-c"
+//  stringutils.NewCharSet("e-a").String()      = "a-e"
+//  stringutils.NewCharSet("A-Za-z_").String()  = "A-Z_a-z"
+//  stringutils.NewCharSet("^0-9").String()     = "\\u0000-/:-\\U0010FFFF"
+//  stringutils.NewCharSet("^a", "^b").String() = "\\u0000-\\U0010FFFF"
+//  stringutils.NewCharSet("a-", "^").String()  = "-^a"
+func NewCharSet(specs ...string) CharSet {
+	var ranges []runeRange
+	for _, spec := range specs {
+		rs := []rune(spec)
+		for i := 0; i < len(rs); {
+			switch n := len(rs) - i; {
+			case n >= 4 && rs[i] == '^' && rs[i+2] == '-':
+				ranges = append(ranges, complementRanges(normalizeRanges([]runeRange{orderedRange(rs[i+1], rs[i+3])}))...)
+				i += 4
+			case n >= 3 && rs[i+1] == '-':
+				ranges = append(ranges, orderedRange(rs[i], rs[i+2]))
+				i += 3
+			case n >= 2 && rs[i] == '^':
+				ranges = append(ranges, complementRanges([]runeRange{{rs[i+1], rs[i+1]}})...)
+				i += 2
+			default:
+				ranges = append(ranges, runeRange{rs[i], rs[i]})
+				i++
+			}
+		}
+	}
+	return newCharSet(ranges)
+}
+
+// NewCharSetFromTable Returns the set of the runes of the table, such as unicode.Greek or unicode.Nd.
+//  stringutils.NewCharSetFromTable(unicode.ASCII_Hex_Digit) = "0-9A-Fa-f"
+func NewCharSetFromTable(t *unicode.RangeTable) CharSet {
+	var ranges []runeRange
+	for _, r := range t.R16 {
+		ranges = appendStrideRange(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	for _, r := range t.R32 {
+		ranges = appendStrideRange(ranges, rune(r.Lo), rune(r.Hi), rune(r.Stride))
+	}
+	return newCharSet(ranges)
+}
+
+// appendStrideRange appends the runes from lo to hi in steps of stride.
+func appendStrideRange(ranges []runeRange, lo, hi, stride rune) []runeRange {
+	if stride == 1 {
+		return append(ranges, runeRange{lo, hi})
+	}
+	for r := lo; r <= hi; r += stride {
+		ranges = append(ranges, runeRange{r, r})
+	}
+	return ranges
+}
+
+// newCharSet builds the set of the ranges, which may overlap and be in any order.
+func newCharSet(ranges []runeRange) CharSet {
+	c := CharSet{ranges: normalizeRanges(ranges)}
+	for _, r := range c.ranges {
+		if r.lo >= utf8.RuneSelf {
+			break
+		}
+		hi := r.hi
+		if hi >= utf8.RuneSelf {
+			hi = utf8.RuneSelf - 1
+		}
+		for b := r.lo; b <= hi; b++ {
+			c.ascii[b/64] |= 1 << uint(b%64)
+		}
+	}
+	return c
+}
+
+// orderedRange returns the range between a and b, whichever is smaller.
+func orderedRange(a, b rune) runeRange {
+	if a > b {
+		a, b = b, a
+	}
+	return runeRange{a, b}
+}
+
+// normalizeRanges sorts the ranges and merges the overlapping and adjacent ones.
+func normalizeRanges(ranges []runeRange) []runeRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sorted := make([]runeRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].lo < sorted[j].lo })
+	merged := sorted[:1]
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.lo <= last.hi+1 {
+			if r.hi > last.hi {
+				last.hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// complementRanges returns the ranges of the runes up to unicode.MaxRune which are not in the
+// normalized ranges.
+func complementRanges(ranges []runeRange) []runeRange {
+	var gaps []runeRange
+	next := rune(0)
+	for _, r := range ranges {
+		if r.lo > next {
+			gaps = append(gaps, runeRange{next, r.lo - 1})
+		}
+		next = r.hi + 1
+	}
+	if next <= unicode.MaxRune {
+		gaps = append(gaps, runeRange{next, unicode.MaxRune})
+	}
+	return gaps
+}
+
+// Contains Checks if the set holds the rune.
+//  stringutils.NewCharSet("a-e").Contains('c')  = true
+//  stringutils.NewCharSet("a-e").Contains('z')  = false
+//  stringutils.NewCharSet("^a-e").Contains('z') = true
+func (c CharSet) Contains(r rune) bool {
+	if r >= 0 && r < utf8.RuneSelf {
+		return c.ascii[r/64]&(1<<uint(r%64)) != 0
+	}
+	i := sort.Search(len(c.ranges), func(i int) bool { return c.ranges[i].hi >= r })
+	return i < len(c.ranges) && c.ranges[i].lo <= r
+}
+
+// Union Returns the set of the runes in c or in o.
+//  stringutils.NewCharSet("a-c").Union(stringutils.NewCharSet("x-z")) = "a-cx-z"
+func (c CharSet) Union(o CharSet) CharSet {
+	ranges := make([]runeRange, 0, len(c.ranges)+len(o.ranges))
+	return newCharSet(append(append(ranges, c.ranges...), o.ranges...))
+}
+
+// Intersect Returns the set of the runes in both c and o.
+//  stringutils.NewCharSet("a-m").Intersect(stringutils.NewCharSet("h-z")) = "h-m"
+func (c CharSet) Intersect(o CharSet) CharSet {
+	return c.Complement().Union(o.Complement()).Complement()
+}
+
+// Complement Returns the set of the runes up to unicode.MaxRune which are not in c.
+//  stringutils.NewCharSet("a-z").Complement() = stringutils.NewCharSet("^a-z")
+func (c CharSet) Complement() CharSet {
+	return newCharSet(complementRanges(c.ranges))
+}
+
+// String returns the ranges of the set, e.g. "A-Z_a-z", with Go escapes for the runes which are
+// not printable.
+func (c CharSet) String() string {
+	var b strings.Builder
+	for _, r := range c.ranges {
+		writeCharSetRune(&b, r.lo)
+		if r.hi > r.lo {
+			b.WriteByte('-')
+			writeCharSetRune(&b, r.hi)
+		}
+	}
+	return b.String()
+}
+
+// writeCharSetRune writes the rune, or its Go escape if it is not printable.
+func writeCharSetRune(b *strings.Builder, r rune) {
+	if unicode.IsPrint(r) {
+		b.WriteRune(r)
+	} else {
+		b.WriteString(escapeRune(r))
+	}
+}
+
+// ContainsAny Checks if s contains any rune of the set.
+//  stringutils.ContainsAny("", stringutils.NewCharSet("a-z"))    = false
+//  stringutils.ContainsAny("ABC", stringutils.NewCharSet("a-z")) = false
+//  stringutils.ContainsAny("ABc", stringutils.NewCharSet("a-z")) = true
+//  stringutils.ContainsAny("a b", stringutils.BlankCharSet)      = true
+func ContainsAny(s string, set CharSet) bool {
+	return IndexOfAny(s, set) >= 0
+}
+
+// ContainsOnly Checks if s contains only runes of the set, an empty string does.
+//  stringutils.ContainsOnly("", stringutils.NewCharSet("a-z"))            = true
+//  stringutils.ContainsOnly("abc", stringutils.NewCharSet("a-z"))         = true
+//  stringutils.ContainsOnly("abC", stringutils.NewCharSet("a-z"))         = false
+//  stringutils.ContainsOnly("0x1F", stringutils.NewCharSet("0-9A-Fa-fx")) = true
+func ContainsOnly(s string, set CharSet) bool {
+	return isEvery(s, set.Contains)
+}
+
+// ContainsNone Checks if s contains no rune of the set, an empty string does.
+//  stringutils.ContainsNone("", stringutils.NewCharSet("a-z"))    = true
+//  stringutils.ContainsNone("ABC", stringutils.NewCharSet("a-z")) = true
+//  stringutils.ContainsNone("ABc", stringutils.NewCharSet("a-z")) = false
+func ContainsNone(s string, set CharSet) bool {
+	return !ContainsAny(s, set)
+}
+
+// IndexOfAny Returns the byte index of the first rune of s in the set, -1 if there is none.
+//  stringutils.IndexOfAny("", stringutils.NewCharSet("a-z"))    = -1
+//  stringutils.IndexOfAny("ABC", stringutils.NewCharSet("a-z")) = -1
+//  stringutils.IndexOfAny("ABc", stringutils.NewCharSet("a-z")) = 2
+//  stringutils.IndexOfAny("été", stringutils.NewCharSet("t"))   = 2
+func IndexOfAny(s string, set CharSet) int {
+	for i, r := range s {
+		if set.Contains(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// StripChars Returns s without the runes of the set at its start and end, with BlankCharSet it strips
+// whitespace as IsBlank defines it.
+//  stringutils.StripChars("", stringutils.NewCharSet("xyz"))        = ""
+//  stringutils.StripChars("xyabcyx", stringutils.NewCharSet("xyz")) = "abc"
+//  stringutils.StripChars("  abc  ", stringutils.NewCharSet(" "))   = "abc"
+//  stringutils.StripChars("\t abc \n", stringutils.BlankCharSet)    = "abc"
+//  stringutils.StripChars("00120", stringutils.NewCharSet("0"))     = "12"
+func StripChars(s string, set CharSet) string {
+	return strings.TrimFunc(s, set.Contains)
+}
@@ -0,0 +1,242 @@
+package stringutils
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestNewCharSet(t *testing.T) {
+	type args struct {
+		specs []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"none", args{nil}, ""},
+		{"empty", args{[]string{""}}, ""},
+		{"abc", args{[]string{"abc"}}, "a-c"},
+		{"a-e", args{[]string{"a-e"}}, "a-e"},
+		{"e-a", args{[]string{"e-a"}}, "a-e"},
+		{"A-Za-z_", args{[]string{"A-Za-z_"}}, "A-Z_a-z"},
+		{"^0-9", args{[]string{"^0-9"}}, `\u0000-/:-\U0010FFFF`},
+		{"^a", args{[]string{"^a"}}, `\u0000-` + "`b-" + `\U0010FFFF`},
+		{"^a,^b", args{[]string{"^a", "^b"}}, `\u0000-\U0010FFFF`},
+		{"^", args{[]string{"^"}}, "^"},
+		{"a-", args{[]string{"a-"}}, "-a"},
+		{"-", args{[]string{"-"}}, "-"},
+		{"a-c,b-e", args{[]string{"a-c", "b-e"}}, "a-e"},
+		{"non ASCII", args{[]string{"α-ω"}}, "α-ω"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCharSet(tt.args.specs...).String(); got != tt.want {
+				t.Errorf("NewCharSet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCharSetFromTable(t *testing.T) {
+	if got := NewCharSetFromTable(unicode.ASCII_Hex_Digit).String(); got != "0-9A-Fa-f" {
+		t.Errorf("NewCharSetFromTable() = %q, want %q", got, "0-9A-Fa-f")
+	}
+	// the Greek table has ranges with a stride
+	greek := NewCharSetFromTable(unicode.Greek)
+	for r := rune(0); r <= 0x2000; r++ {
+		if got := greek.Contains(r); got != unicode.Is(unicode.Greek, r) {
+			t.Fatalf("NewCharSetFromTable(unicode.Greek).Contains(%U) = %v", r, got)
+		}
+	}
+}
+
+func TestBlankCharSet(t *testing.T) {
+	for r := rune(0); r <= 0x3000; r++ {
+		if got, want := BlankCharSet.Contains(r), IsBlank(string(r)); got != want {
+			t.Errorf("BlankCharSet.Contains(%U) = %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestCharSet_Contains(t *testing.T) {
+	type args struct {
+		spec string
+		r    rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", 'a'}, false},
+		{"a-e c", args{"a-e", 'c'}, true},
+		{"a-e a", args{"a-e", 'a'}, true},
+		{"a-e e", args{"a-e", 'e'}, true},
+		{"a-e z", args{"a-e", 'z'}, false},
+		{"^a-e z", args{"^a-e", 'z'}, true},
+		{"^a-e c", args{"^a-e", 'c'}, false},
+		{"^a-e \\u00e9", args{"^a-e", 'é'}, true},
+		{"\\u0080-\\uffff \\u00e9", args{"\u0080-\uffff", 'é'}, true},
+		{"\\u0080-\\uffff \\U0001F600", args{"\u0080-\uffff", '\U0001F600'}, false},
+		{"\\x7f", args{"\x7f", '\x7f'}, true},
+		{"a-z negative", args{"a-z", -1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewCharSet(tt.args.spec).Contains(tt.args.r); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCharSet_Operations(t *testing.T) {
+	tests := []struct {
+		name string
+		got  CharSet
+		want string
+	}{
+		{"union", NewCharSet("a-c").Union(NewCharSet("x-z")), "a-cx-z"},
+		{"union adjacent", NewCharSet("a-c").Union(NewCharSet("d-f")), "a-f"},
+		{"union empty", NewCharSet("a-c").Union(CharSet{}), "a-c"},
+		{"intersect", NewCharSet("a-m").Intersect(NewCharSet("h-z")), "h-m"},
+		{"intersect disjoint", NewCharSet("a-c").Intersect(NewCharSet("x-z")), ""},
+		{"intersect negated", NewCharSet("a-z").Intersect(NewCharSet("^aeiou")), "b-z"},
+		{"complement", NewCharSet("a-z").Complement(), NewCharSet("^a-z").String()},
+		{"complement empty", CharSet{}.Complement(), `\u0000-\U0010FFFF`},
+		{"complement twice", NewCharSet("a-z_").Complement().Complement(), "_a-z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, false},
+		{"empty set", args{"abc", CharSet{}}, false},
+		{"ABC", args{"ABC", NewCharSet("a-z")}, false},
+		{"ABc", args{"ABc", NewCharSet("a-z")}, true},
+		{"blank", args{"a\u00A0b", BlankCharSet}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsAny(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("ContainsAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsOnly(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, true},
+		{"empty set", args{"abc", CharSet{}}, false},
+		{"abc", args{"abc", NewCharSet("a-z")}, true},
+		{"abC", args{"abC", NewCharSet("a-z")}, false},
+		{"hex", args{"0x1F", NewCharSet("0-9A-Fa-fx")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsOnly(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("ContainsOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsNone(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, true},
+		{"ABC", args{"ABC", NewCharSet("a-z")}, true},
+		{"ABc", args{"ABc", NewCharSet("a-z")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsNone(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("ContainsNone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexOfAny(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, -1},
+		{"ABC", args{"ABC", NewCharSet("a-z")}, -1},
+		{"ABc", args{"ABc", NewCharSet("a-z")}, 2},
+		{"\\u00e9t\\u00e9", args{"été", NewCharSet("t")}, 2},
+		{"negated", args{"aab", NewCharSet("^a")}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOfAny(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("IndexOfAny() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripChars(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NewCharSet("xyz")}, ""},
+		{"empty set", args{"  abc  ", CharSet{}}, "  abc  "},
+		{"xyabcyx", args{"xyabcyx", NewCharSet("xyz")}, "abc"},
+		{"spaces", args{"  abc  ", NewCharSet(" ")}, "abc"},
+		{"blank", args{"\t abc\u00A0\n", BlankCharSet}, "abc"},
+		{"00120", args{"00120", NewCharSet("0")}, "12"},
+		{"all", args{"xyz", NewCharSet("xyz")}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripChars(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("StripChars() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
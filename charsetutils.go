@@ -0,0 +1,105 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Squeeze Returns s with every run of the same rune of the set collapsed to a single rune. The other
+// bytes are kept as they are, invalid UTF-8 included.
+//  stringutils.Squeeze("", stringutils.NewCharSet("a-z"))      = ""
+//  stringutils.Squeeze("hello", stringutils.NewCharSet("k-p")) = "helo"
+//  stringutils.Squeeze("hello", stringutils.NewCharSet("a-e")) = "hello"
+//  stringutils.Squeeze("a  b\t\tc", stringutils.BlankCharSet)  = "a b\tc"
+//  stringutils.Squeeze("aabbaa", stringutils.NewCharSet("a"))  = "abba"
+func Squeeze(s string, set CharSet) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	last := rune(-1)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			// an invalid byte is not a rune of the set
+			r = -1
+		} else if r == last && set.Contains(r) {
+			i += size
+			continue
+		}
+		b.WriteString(s[i : i+size])
+		last = r
+		i += size
+	}
+	return b.String()
+}
+
+// Delete Returns s without the runes of the set.
+//  stringutils.Delete("", stringutils.NewCharSet("a-z"))                = ""
+//  stringutils.Delete("hello", stringutils.NewCharSet("hl"))            = "eo"
+//  stringutils.Delete("hello", stringutils.NewCharSet("le"))            = "ho"
+//  stringutils.Delete("id=42, pin=1234", stringutils.NewCharSet("0-9")) = "id=, pin="
+func Delete(s string, set CharSet) string {
+	return strings.Map(func(r rune) rune {
+		if set.Contains(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Keep Returns s with only the runes of the set.
+//  stringutils.Keep("", stringutils.NewCharSet("a-z"))                = ""
+//  stringutils.Keep("hello", stringutils.NewCharSet("hl"))            = "hll"
+//  stringutils.Keep("hello", stringutils.NewCharSet("le"))            = "ell"
+//  stringutils.Keep("+1 (555) 010-99", stringutils.NewCharSet("0-9")) = "155501099"
+func Keep(s string, set CharSet) string {
+	return strings.Map(func(r rune) rune {
+		if set.Contains(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Count Returns the number of runes of s in the set.
+//  stringutils.Count("", stringutils.NewCharSet("a-z"))      = 0
+//  stringutils.Count("hello", stringutils.NewCharSet("k-p")) = 3
+//  stringutils.Count("hello", stringutils.NewCharSet("a-e")) = 1
+//  stringutils.Count("été", stringutils.NewCharSet("^a-z"))  = 2
+func Count(s string, set CharSet) int {
+	n := 0
+	for _, r := range s {
+		if set.Contains(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// NormalizeSpace Returns s with its whitespace, as IsBlank defines it, stripped at its start and end
+// and every run of whitespace inside replaced with a single space. The other bytes are kept as they
+// are, invalid UTF-8 included.
+//  stringutils.NormalizeSpace("")               = ""
+//  stringutils.NormalizeSpace("   ")            = ""
+//  stringutils.NormalizeSpace("abc")            = "abc"
+//  stringutils.NormalizeSpace("  a  b\t\n c  ") = "a b c"
+//  stringutils.NormalizeSpace("a\u00A0\u2003b") = "a b"
+func NormalizeSpace(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	space := false
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if BlankCharSet.Contains(r) {
+			space = b.Len() > 0
+			i += size
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteString(s[i : i+size])
+		i += size
+	}
+	return b.String()
+}
@@ -0,0 +1,137 @@
+package stringutils
+
+import "testing"
+
+func TestSqueeze(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, ""},
+		{"empty set", args{"hello", CharSet{}}, "hello"},
+		{"hello k-p", args{"hello", NewCharSet("k-p")}, "helo"},
+		{"hello a-e", args{"hello", NewCharSet("a-e")}, "hello"},
+		{"blank", args{"a  b\t\tc", BlankCharSet}, "a b\tc"},
+		{"aabbaa", args{"aabbaa", NewCharSet("a")}, "abba"},
+		{"non ASCII", args{"ééété", NewCharSet("^a-z")}, "été"},
+		{"invalid utf8", args{"\xff", NewCharSet("a")}, "\xff"},
+		{"invalid utf8 run", args{"aa\xff\xffbb", NewCharSet("a-b")}, "a\xff\xffb"},
+		{"replacement char", args{"\ufffd\ufffd\xff", NewCharSet("\ufffd")}, "\ufffd\xff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Squeeze(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("Squeeze() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, ""},
+		{"empty set", args{"hello", CharSet{}}, "hello"},
+		{"hello hl", args{"hello", NewCharSet("hl")}, "eo"},
+		{"hello le", args{"hello", NewCharSet("le")}, "ho"},
+		{"digits", args{"id=42, pin=1234", NewCharSet("0-9")}, "id=, pin="},
+		{"literal caret", args{"a^b", NewCharSet("^")}, "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Delete(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("Delete() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeep(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, ""},
+		{"empty set", args{"hello", CharSet{}}, ""},
+		{"hello hl", args{"hello", NewCharSet("hl")}, "hll"},
+		{"hello le", args{"hello", NewCharSet("le")}, "ell"},
+		{"digits", args{"+1 (555) 010-99", NewCharSet("0-9")}, "155501099"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Keep(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("Keep() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	type args struct {
+		s   string
+		set CharSet
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", NewCharSet("a-z")}, 0},
+		{"empty set", args{"hello", CharSet{}}, 0},
+		{"hello k-p", args{"hello", NewCharSet("k-p")}, 3},
+		{"hello a-e", args{"hello", NewCharSet("a-e")}, 1},
+		{"non ASCII", args{"été", NewCharSet("^a-z")}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Count(tt.args.s, tt.args.set); got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeSpace(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{""}, ""},
+		{"spaces", args{"   "}, ""},
+		{"abc", args{"abc"}, "abc"},
+		{"a b", args{"a b"}, "a b"},
+		{"runs", args{"  a  b\t\n c  "}, "a b c"},
+		{"unicode spaces", args{"a\u00A0\u2003b"}, "a b"},
+		{"information separators", args{"\u001ca\u001d\u001eb\u001f"}, "a b"},
+		{"zero width space", args{"a\u200Bb"}, "a\u200Bb"},
+		{"invalid utf8", args{" a\xff  b\xfe "}, "a\xff b\xfe"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeSpace(tt.args.s); got != tt.want {
+				t.Errorf("NormalizeSpace() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
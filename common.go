@@ -0,0 +1,111 @@
+package stringutils
+
+import "sort"
+
+// GetCommonPrefix Returns the longest prefix shared by all the strings. The prefix ends on a grapheme
+// cluster boundary of every string, so a character is never split from its combining marks.
+//  stringutils.GetCommonPrefix()                                  = "", error
+//  stringutils.GetCommonPrefix("abc")                             = "abc"
+//  stringutils.GetCommonPrefix("", "abc")                         = ""
+//  stringutils.GetCommonPrefix("abc", "abc")                      = "abc"
+//  stringutils.GetCommonPrefix("abcde", "abxyz")                  = "ab"
+//  stringutils.GetCommonPrefix("i am a machine", "i am a robot") = "i am a "
+//  stringutils.GetCommonPrefix("e\u0301", "e")                    = ""
+func GetCommonPrefix(ss ...string) (string, error) {
+	if len(ss) == 0 {
+		return "", ErrNoArguments
+	}
+	return ss[0][:commonPrefixLen(ss)], nil
+}
+
+// GetCommonSuffix Returns the longest suffix shared by all the strings. The suffix starts on a grapheme
+// cluster boundary of every string, so a character is never split from its combining marks.
+//  stringutils.GetCommonSuffix()                   = "", error
+//  stringutils.GetCommonSuffix("abc")              = "abc"
+//  stringutils.GetCommonSuffix("", "abc")          = ""
+//  stringutils.GetCommonSuffix("abcde", "xyzde")   = "de"
+//  stringutils.GetCommonSuffix("a\u0301", "\u0301") = ""
+func GetCommonSuffix(ss ...string) (string, error) {
+	if len(ss) == 0 {
+		return "", ErrNoArguments
+	}
+	return ss[0][len(ss[0])-commonSuffixLen(ss):], nil
+}
+
+// IndexOfDifference Returns the byte index at which the strings begin to differ, the length of their
+// common prefix, see GetCommonPrefix. Fewer than two strings or equal strings return -1.
+//  stringutils.IndexOfDifference()                 = -1, error
+//  stringutils.IndexOfDifference("abc")            = -1
+//  stringutils.IndexOfDifference("abc", "abc")     = -1
+//  stringutils.IndexOfDifference("", "abc")        = 0
+//  stringutils.IndexOfDifference("abcde", "abxyz") = 2
+//  stringutils.IndexOfDifference("abcde", "xyz")   = 0
+func IndexOfDifference(ss ...string) (int, error) {
+	if len(ss) == 0 {
+		return -1, ErrNoArguments
+	}
+	n := commonPrefixLen(ss)
+	for _, s := range ss {
+		if len(s) != n {
+			return n, nil
+		}
+	}
+	return -1, nil
+}
+
+// Difference Returns the remainder of b from where it differs from a, see IndexOfDifference.
+//  stringutils.Difference("", "")           = ""
+//  stringutils.Difference("", "abc")        = "abc"
+//  stringutils.Difference("abc", "")        = ""
+//  stringutils.Difference("abc", "abc")     = ""
+//  stringutils.Difference("ab", "abxyz")    = "xyz"
+//  stringutils.Difference("abcde", "abxyz") = "xyz"
+//  stringutils.Difference("abcde", "xyz")   = "xyz"
+func Difference(a, b string) string {
+	i, _ := IndexOfDifference(a, b)
+	if i == -1 {
+		return ""
+	}
+	return b[i:]
+}
+
+// commonPrefixLen returns the length in bytes of the common prefix of the strings, ending on a
+// grapheme cluster boundary of all of them.
+func commonPrefixLen(ss []string) int {
+	first := ss[0]
+	i := 0
+	for i < len(first) {
+		n := graphemeLen(first[i:])
+		for _, s := range ss[1:] {
+			if len(s)-i < n || s[i:i+n] != first[i:i+n] || graphemeLen(s[i:]) != n {
+				return i
+			}
+		}
+		i += n
+	}
+	return i
+}
+
+// commonSuffixLen returns the length in bytes of the common suffix of the strings, starting on a
+// grapheme cluster boundary of all of them.
+func commonSuffixLen(ss []string) int {
+	boundaries := make([][]int, len(ss))
+	for k, s := range ss {
+		boundaries[k] = graphemeBoundaries(s)
+	}
+	first, b0 := ss[0], boundaries[0]
+	n := 0
+	for k := len(b0) - 2; k >= 0; k-- {
+		m := len(first) - b0[k]
+		for j, s := range ss[1:] {
+			if len(s) < m || s[len(s)-m:len(s)-n] != first[b0[k]:len(first)-n] {
+				return n
+			}
+			if b := boundaries[j+1]; b[sort.SearchInts(b, len(s)-m)] != len(s)-m {
+				return n
+			}
+		}
+		n = m
+	}
+	return n
+}
@@ -0,0 +1,143 @@
+package stringutils
+
+import "testing"
+
+func TestGetCommonPrefix(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, "", true},
+		{"[abc]", args{[]string{"abc"}}, "abc", false},
+		{"[empty,abc]", args{[]string{"", "abc"}}, "", false},
+		{"[abc,abc]", args{[]string{"abc", "abc"}}, "abc", false},
+		{"[abc,a]", args{[]string{"abc", "a"}}, "a", false},
+		{"[abcde,abxyz]", args{[]string{"abcde", "abxyz"}}, "ab", false},
+		{"[abcde,xyz]", args{[]string{"abcde", "xyz"}}, "", false},
+		{"[machine,robot]", args{[]string{"i am a machine", "i am a robot"}}, "i am a ", false},
+		{"[abc,abd,ab]", args{[]string{"abc", "abd", "ab"}}, "ab", false},
+		{"combining mark", args{[]string{"e\u0301", "e"}}, "", false},
+		{"combining marks", args{[]string{"ae\u0301", "ae\u0300"}}, "a", false},
+		{"emoji sequence", args{[]string{"\U0001F468\u200d\U0001F469", "\U0001F468\u200d\U0001F466"}}, "", false},
+		{"flags", args{[]string{"\U0001F1FA\U0001F1F8", "\U0001F1FA\U0001F1E6"}}, "", false},
+		{"runes", args{[]string{"日本語", "日本人"}}, "日本", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetCommonPrefix(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCommonPrefix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetCommonPrefix() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetCommonSuffix(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, "", true},
+		{"[abc]", args{[]string{"abc"}}, "abc", false},
+		{"[empty,abc]", args{[]string{"", "abc"}}, "", false},
+		{"[abc,abc]", args{[]string{"abc", "abc"}}, "abc", false},
+		{"[abc,c]", args{[]string{"abc", "c"}}, "c", false},
+		{"[abcde,xyzde]", args{[]string{"abcde", "xyzde"}}, "de", false},
+		{"[abc,xbc,c]", args{[]string{"abc", "xbc", "c"}}, "c", false},
+		{"combining mark", args{[]string{"a\u0301", "\u0301"}}, "", false},
+		{"combining marks", args{[]string{"xa\u0301", "ya\u0301"}}, "a\u0301", false},
+		{"hangul", args{[]string{"\u1100\u1161", "\u1161"}}, "", false},
+		{"runes", args{[]string{"日本語", "英語"}}, "語", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetCommonSuffix(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCommonSuffix() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetCommonSuffix() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexOfDifference(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, -1, true},
+		{"[abc]", args{[]string{"abc"}}, -1, false},
+		{"[empty,empty]", args{[]string{"", ""}}, -1, false},
+		{"[empty,abc]", args{[]string{"", "abc"}}, 0, false},
+		{"[abc,empty]", args{[]string{"abc", ""}}, 0, false},
+		{"[abc,abc]", args{[]string{"abc", "abc"}}, -1, false},
+		{"[abc,abc,abc]", args{[]string{"abc", "abc", "abc"}}, -1, false},
+		{"[abc,abc,ab]", args{[]string{"abc", "abc", "ab"}}, 2, false},
+		{"[abcde,abxyz]", args{[]string{"abcde", "abxyz"}}, 2, false},
+		{"[abcde,xyz]", args{[]string{"abcde", "xyz"}}, 0, false},
+		{"combining mark", args{[]string{"ae\u0301", "ae"}}, 1, false},
+		{"runes", args{[]string{"日本語", "日本人"}}, 6, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IndexOfDifference(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IndexOfDifference() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IndexOfDifference() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty,empty", args{"", ""}, ""},
+		{"empty,abc", args{"", "abc"}, "abc"},
+		{"abc,empty", args{"abc", ""}, ""},
+		{"abc,abc", args{"abc", "abc"}, ""},
+		{"ab,abxyz", args{"ab", "abxyz"}, "xyz"},
+		{"abcde,abxyz", args{"abcde", "abxyz"}, "xyz"},
+		{"abcde,xyz", args{"abcde", "xyz"}, "xyz"},
+		{"combining mark", args{"e", "e\u0301"}, "e\u0301"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Difference(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Difference() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package stringutils
+
+import (
+	_ "embed" // confusables mappings
+	"strings"
+	"sync"
+)
+
+//go:embed data/confusables.txt
+var confusablesTxt string
+
+var (
+	confusablesOnce      sync.Once
+	confusablePrototypes map[rune]string
+)
+
+// loadConfusables parses the embedded confusables.txt of UTS #39, lines have the form
+//  <source> ; <prototype> ; MA # <comment>
+func loadConfusables() {
+	confusablePrototypes = make(map[rune]string)
+	for _, line := range strings.Split(strings.TrimPrefix(confusablesTxt, "\ufeff"), "\n") {
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+		source, prototype := parseCodePoints(fields[0]), parseCodePoints(fields[1])
+		if len(source) != 1 || len(prototype) == 0 {
+			continue
+		}
+		confusablePrototypes[source[0]] = string(prototype)
+	}
+}
+
+// Skeleton Returns the skeleton of s of UTS #39: s decomposed, with every rune replaced by the
+// prototype of the characters it may be confused with, and decomposed again. Strings which look
+// alike have the same skeleton, it is meant for comparisons and not for display. The prototypes are
+// those of confusables.txt 15.1.0, embedded from data/confusables.txt.
+//  stringutils.Skeleton("")                   = ""
+//  stringutils.Skeleton("paypal")             = "paypal"
+//  stringutils.Skeleton("p\u0430yp\u0430l")   = "paypal"
+//  stringutils.Skeleton("I1l|")               = "llll"
+//  stringutils.Skeleton("modern")             = "rnodern"
+//  stringutils.Skeleton("\uff50\uff41\uff59") = "pay"
+func Skeleton(s string) string {
+	confusablesOnce.Do(loadConfusables)
+	s = Normalize(s, NFD)
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if p, ok := confusablePrototypes[r]; ok {
+			b.WriteString(p)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return Normalize(b.String(), NFD)
+}
+
+// AreConfusable Checks if a and b may be taken for each other, they have the same Skeleton.
+//  stringutils.AreConfusable("", "")                   = true
+//  stringutils.AreConfusable("paypal", "p\u0430ypal")  = true
+//  stringutils.AreConfusable("paypal", "paypa1")       = true
+//  stringutils.AreConfusable("modern", "rnodern")      = true
+//  stringutils.AreConfusable("paypal", "Paypal")       = false
+func AreConfusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
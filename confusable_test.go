@@ -0,0 +1,82 @@
+package stringutils
+
+import "testing"
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"ascii", "paypal", "paypal"},
+		{"cyrillic a", "p\u0430yp\u0430l", "paypal"},
+		{"greek omicron", "g\u03bf\u03bfgle", "google"},
+		{"cyrillic capitals", "\u0410\u0412\u0421", "ABC"},
+		{"one, capital i and pipe", "I1l|", "llll"},
+		{"zero", "g00gle", "gOOgle"},
+		{"m", "modern", "rnodern"},
+		{"fullwidth", "\uff50\uff41\uff59", "pay"},
+		{"mathematical", "\U0001d41a\U0001d41b\U0001d41c", "abc"},
+		{"decomposed", "café", "cafe\u0301"},
+		{"cyrillic i with diaeresis", "\u0457", "i\u0308"},
+		{"unmapped", "日本", "日本"},
+		{"cherokee", "\u13aa\u13a5", "Ai"},
+		{"lisu", "\ua4ee\ua4e0\ua4f2", "ANl"},
+		{"deseret", "\U00010420", "S"},
+		{"mathematical monospace and sans-serif", "\U0001d68a\U0001d5ba", "aa"},
+		{"mathematical digits", "\U0001d7ce\U0001d7f6", "OO"},
+		{"fullwidth punctuation", "hi\uff01", "hi!"},
+		{"latin alpha and script g", "\u0251\u0261", "ag"},
+		{"click and leader", "\u01c3\u2024", "!."},
+		{"malayalam", "g\u0d20\u0d20gle", "google"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Skeleton(tt.s); got != tt.want {
+				t.Errorf("Skeleton() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAreConfusable(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", ""}, true},
+		{"equal", args{"paypal", "paypal"}, true},
+		{"cyrillic", args{"paypal", "p\u0430ypal"}, true},
+		{"digit", args{"paypal", "paypa1"}, true},
+		{"rn", args{"modern", "rnodern"}, true},
+		{"composed", args{"café", "cafe\u0301"}, true},
+		{"cyrillic composed", args{"café", "caf\u0435\u0301"}, true},
+		{"cherokee and lisu", args{"\u13aa\ua4ee", "AA"}, true},
+		{"fullwidth punctuation", args{"wow!", "wow\uff01"}, true},
+		{"case", args{"paypal", "Paypal"}, false},
+		{"different", args{"paypal", "paypai"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AreConfusable(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("AreConfusable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfusables(t *testing.T) {
+	confusablesOnce.Do(loadConfusables)
+	if n := len(confusablePrototypes); n < 6000 {
+		t.Errorf("loadConfusables() loaded %d prototypes, want the full confusables.txt", n)
+	}
+	if got := confusablePrototypes['%']; got != "\u00ba/\u2080" {
+		t.Errorf("confusablePrototypes['%%'] = %q, want %q", got, "\u00ba/\u2080")
+	}
+}
@@ -0,0 +1,308 @@
+package stringutils
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DiffOp is the kind of a DiffEdit.
+type DiffOp int
+
+const (
+	// DiffEqual is text present in both strings.
+	DiffEqual DiffOp = iota
+	// DiffInsert is text present only in the second string.
+	DiffInsert
+	// DiffDelete is text present only in the first string.
+	DiffDelete
+)
+
+// String returns the name of the operation.
+func (op DiffOp) String() string {
+	switch op {
+	case DiffEqual:
+		return "Equal"
+	case DiffInsert:
+		return "Insert"
+	case DiffDelete:
+		return "Delete"
+	}
+	return "DiffOp(" + strconv.Itoa(int(op)) + ")"
+}
+
+// DiffEdit is a run of text that is equal, inserted or deleted.
+type DiffEdit struct {
+	Op   DiffOp
+	Text string
+}
+
+// Diff Returns the edits turning a into b, compared rune by rune with Myers' algorithm
+// and cleaned up semantically, so that short coincidental equalities do not split the edits.
+//  stringutils.Diff("", "")              = []
+//  stringutils.Diff("abc", "abc")        = [{Equal "abc"}]
+//  stringutils.Diff("abc", "ab")         = [{Equal "ab"} {Delete "c"}]
+//  stringutils.Diff("kitten", "sitting") = [{Delete "k"} {Insert "s"} {Equal "itt"} {Delete "en"} {Insert "ing"}]
+func Diff(a, b string) []DiffEdit {
+	return diffCleanupSemantic(diffTokens(splitRunes(a), splitRunes(b), true), true)
+}
+
+// DiffWords Returns the edits turning a into b, compared word by word, see Diff. A word is a run
+// of letters, digits and underscores, a run of whitespace or any other single rune.
+//  stringutils.DiffWords("the quick fox", "the slow fox") = [{Equal "the "} {Delete "quick"} {Insert "slow"} {Equal " fox"}]
+func DiffWords(a, b string) []DiffEdit {
+	return diffCleanupSemantic(diffTokens(splitWords(a), splitWords(b), false), false)
+}
+
+// DiffLines Returns the edits turning a into b, compared line by line, see Diff.
+// Every line keeps its terminating "\n".
+//  stringutils.DiffLines("a\nb\nc\n", "a\nc\n") = [{Equal "a\n"} {Delete "b\n"} {Equal "c\n"}]
+func DiffLines(a, b string) []DiffEdit {
+	return diffCleanupSemantic(diffTokens(splitLines(a), splitLines(b), false), false)
+}
+
+// DiffSource Returns the first string of the edits, the text of all equal and deleted edits.
+func DiffSource(edits []DiffEdit) string {
+	var b strings.Builder
+	for _, e := range edits {
+		if e.Op != DiffInsert {
+			b.WriteString(e.Text)
+		}
+	}
+	return b.String()
+}
+
+// DiffTarget Returns the second string of the edits, the text of all equal and inserted edits.
+func DiffTarget(edits []DiffEdit) string {
+	var b strings.Builder
+	for _, e := range edits {
+		if e.Op != DiffDelete {
+			b.WriteString(e.Text)
+		}
+	}
+	return b.String()
+}
+
+func splitRunes(s string) []string {
+	tokens := make([]string, 0, len(s))
+	for i := 0; i < len(s); {
+		_, size := utf8.DecodeRuneInString(s[i:])
+		tokens = append(tokens, s[i:i+size])
+		i += size
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+func splitWords(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		j := i + size
+		switch {
+		case isWordRune(r):
+			for j < len(s) {
+				r, size := utf8.DecodeRuneInString(s[j:])
+				if !isWordRune(r) {
+					break
+				}
+				j += size
+			}
+		case unicode.IsSpace(r):
+			for j < len(s) {
+				r, size := utf8.DecodeRuneInString(s[j:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				j += size
+			}
+		}
+		tokens = append(tokens, s[i:j])
+		i = j
+	}
+	return tokens
+}
+
+func splitLines(s string) []string {
+	var tokens []string
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\n') + 1
+		if i == 0 {
+			i = len(s)
+		}
+		tokens = append(tokens, s[:i])
+		s = s[i:]
+	}
+	return tokens
+}
+
+// diffTokens diffs two token lists, the tokens are mapped to integers so that Myers' algorithm
+// compares them in constant time, and the result is merged into maximal runs, see diffMerge.
+func diffTokens(a, b []string, runeLevel bool) []DiffEdit {
+	ids := make(map[string]int)
+	toIDs := func(tokens []string) []int {
+		out := make([]int, len(tokens))
+		for i, t := range tokens {
+			id, ok := ids[t]
+			if !ok {
+				id = len(ids)
+				ids[t] = id
+			}
+			out[i] = id
+		}
+		return out
+	}
+	d := myersDiff{a: a, b: b}
+	d.diff(toIDs(a), toIDs(b), 0, 0)
+	return diffMerge(d.edits, runeLevel)
+}
+
+// myersDiff collects the edits of a token diff.
+type myersDiff struct {
+	a, b  []string
+	edits []DiffEdit
+}
+
+func (d *myersDiff) emit(op DiffOp, tokens []string) {
+	if len(tokens) > 0 {
+		d.edits = append(d.edits, DiffEdit{Op: op, Text: strings.Join(tokens, "")})
+	}
+}
+
+// diff appends the edits turning a into b, which start at the token offsets ao and bo.
+func (d *myersDiff) diff(a, b []int, ao, bo int) {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	d.emit(DiffEqual, d.a[ao:ao+prefix])
+	a, b, ao, bo = a[prefix:], b[prefix:], ao+prefix, bo+prefix
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+	switch {
+	case len(a) == 0:
+		d.emit(DiffInsert, d.b[bo:bo+len(b)])
+	case len(b) == 0:
+		d.emit(DiffDelete, d.a[ao:ao+len(a)])
+	case len(a) == 1 || len(b) == 1:
+		d.diffShort(a, b, ao, bo)
+	default:
+		x, y, ok := myersMiddleSnake(a, b)
+		if ok {
+			d.diff(a[:x], b[:y], ao, bo)
+			d.diff(a[x:], b[y:], ao+x, bo+y)
+		} else {
+			d.emit(DiffDelete, d.a[ao:ao+len(a)])
+			d.emit(DiffInsert, d.b[bo:bo+len(b)])
+		}
+	}
+	d.emit(DiffEqual, d.a[ao+len(a):ao+len(a)+suffix])
+}
+
+// diffShort diffs token lists where one has a single token, which is either found in the other or replaced.
+func (d *myersDiff) diffShort(a, b []int, ao, bo int) {
+	if len(a) == 1 {
+		for j, t := range b {
+			if t == a[0] {
+				d.emit(DiffInsert, d.b[bo:bo+j])
+				d.emit(DiffEqual, d.a[ao:ao+1])
+				d.emit(DiffInsert, d.b[bo+j+1:bo+len(b)])
+				return
+			}
+		}
+	} else {
+		for i, t := range a {
+			if t == b[0] {
+				d.emit(DiffDelete, d.a[ao:ao+i])
+				d.emit(DiffEqual, d.a[ao+i:ao+i+1])
+				d.emit(DiffDelete, d.a[ao+i+1:ao+len(a)])
+				return
+			}
+		}
+	}
+	d.emit(DiffDelete, d.a[ao:ao+len(a)])
+	d.emit(DiffInsert, d.b[bo:bo+len(b)])
+}
+
+// myersMiddleSnake finds the middle snake of a shortest edit script by searching from both ends
+// at once, as in "An O(ND) Difference Algorithm and Its Variations", and returns the point
+// where the script can be split. Memory use is linear in the length of the inputs.
+func myersMiddleSnake(a, b []int) (int, int, bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	size := 2 * maxD
+	v1, v2 := make([]int, size), make([]int, size)
+	for i := range v1 {
+		v1[i], v2[i] = -1, -1
+	}
+	v1[offset+1], v2[offset+1] = 0, 0
+	delta := n - m
+	front := delta%2 != 0
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k1 := -d + k1start; k1 <= d-k1end; k1 += 2 {
+			k1off := offset + k1
+			var x1 int
+			if k1 == -d || (k1 != d && v1[k1off-1] < v1[k1off+1]) {
+				x1 = v1[k1off+1]
+			} else {
+				x1 = v1[k1off-1] + 1
+			}
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			v1[k1off] = x1
+			switch {
+			case x1 > n:
+				k1end += 2
+			case y1 > m:
+				k1start += 2
+			case front:
+				if k2off := offset + delta - k1; k2off >= 0 && k2off < size && v2[k2off] != -1 {
+					if x1 >= n-v2[k2off] {
+						return x1, y1, true
+					}
+				}
+			}
+		}
+		for k2 := -d + k2start; k2 <= d-k2end; k2 += 2 {
+			k2off := offset + k2
+			var x2 int
+			if k2 == -d || (k2 != d && v2[k2off-1] < v2[k2off+1]) {
+				x2 = v2[k2off+1]
+			} else {
+				x2 = v2[k2off-1] + 1
+			}
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			v2[k2off] = x2
+			switch {
+			case x2 > n:
+				k2end += 2
+			case y2 > m:
+				k2start += 2
+			case !front:
+				if k1off := offset + delta - k2; k1off >= 0 && k1off < size && v1[k1off] != -1 {
+					x1 := v1[k1off]
+					if x1 >= n-x2 {
+						return x1, offset + x1 - k1off, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
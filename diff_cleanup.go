@@ -0,0 +1,253 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DiffCleanupSemantic Returns the edits with coincidental equalities removed: an equality no longer
+// than the edits on both of its sides is turned into a deletion and an insertion, and the remaining
+// edits are shifted to word and line boundaries. The result describes the same change in fewer,
+// more readable edits.
+//  stringutils.DiffCleanupSemantic([{Delete "a"} {Equal "b"} {Delete "c"}]) = [{Delete "abc"} {Insert "b"}]
+func DiffCleanupSemantic(edits []DiffEdit) []DiffEdit {
+	return diffCleanupSemantic(edits, true)
+}
+
+// diffCleanupSemantic is DiffCleanupSemantic, runeLevel also allows edits to be split or shifted
+// inside the runs of text, which would break the tokens of word and line diffs.
+func diffCleanupSemantic(edits []DiffEdit, runeLevel bool) []DiffEdit {
+	edits = append([]DiffEdit(nil), edits...)
+	changes := false
+	var equalities []int
+	lastEquality, hasEquality := "", false
+	insertions1, deletions1, insertions2, deletions2 := 0, 0, 0, 0
+	for i := 0; i < len(edits); i++ {
+		if edits[i].Op == DiffEqual {
+			equalities = append(equalities, i)
+			insertions1, deletions1 = insertions2, deletions2
+			insertions2, deletions2 = 0, 0
+			lastEquality, hasEquality = edits[i].Text, true
+			continue
+		}
+		if edits[i].Op == DiffInsert {
+			insertions2 += utf8.RuneCountInString(edits[i].Text)
+		} else {
+			deletions2 += utf8.RuneCountInString(edits[i].Text)
+		}
+		n := utf8.RuneCountInString(lastEquality)
+		if hasEquality && n <= maxInt(insertions1, deletions1) && n <= maxInt(insertions2, deletions2) {
+			at := equalities[len(equalities)-1]
+			edits = append(edits[:at], append([]DiffEdit{{DiffDelete, lastEquality}}, edits[at:]...)...)
+			edits[at+1].Op = DiffInsert
+			equalities = equalities[:len(equalities)-1]
+			if len(equalities) > 0 {
+				equalities = equalities[:len(equalities)-1]
+			}
+			i = -1
+			if len(equalities) > 0 {
+				i = equalities[len(equalities)-1]
+			}
+			insertions1, deletions1, insertions2, deletions2 = 0, 0, 0, 0
+			lastEquality, hasEquality = "", false
+			changes = true
+		}
+	}
+	if changes {
+		edits = diffMerge(edits, runeLevel)
+	}
+	if runeLevel {
+		edits = diffCleanupLossless(edits)
+	}
+	return edits
+}
+
+// diffMerge merges every run of edits between two equalities into one deletion followed by one
+// insertion and joins adjacent equalities. With runeLevel it also moves common prefixes and
+// suffixes of the deletion and insertion into the equalities, and slides single edits over
+// the equalities around them where that removes one.
+func diffMerge(edits []DiffEdit, runeLevel bool) []DiffEdit {
+	var out []DiffEdit
+	var del, ins strings.Builder
+	flush := func(next *DiffEdit) {
+		d, i := del.String(), ins.String()
+		del.Reset()
+		ins.Reset()
+		if runeLevel && d != "" && i != "" {
+			if n := diffCommonPrefix(i, d); n > 0 {
+				if len(out) > 0 && out[len(out)-1].Op == DiffEqual {
+					out[len(out)-1].Text += i[:n]
+				} else {
+					out = append(out, DiffEdit{DiffEqual, i[:n]})
+				}
+				d, i = d[n:], i[n:]
+			}
+			if n := diffCommonSuffix(i, d); n > 0 && next != nil {
+				next.Text = i[len(i)-n:] + next.Text
+				d, i = d[:len(d)-n], i[:len(i)-n]
+			}
+		}
+		if d != "" {
+			out = append(out, DiffEdit{DiffDelete, d})
+		}
+		if i != "" {
+			out = append(out, DiffEdit{DiffInsert, i})
+		}
+	}
+	for k := 0; k < len(edits); k++ {
+		e := edits[k]
+		switch e.Op {
+		case DiffDelete:
+			del.WriteString(e.Text)
+		case DiffInsert:
+			ins.WriteString(e.Text)
+		case DiffEqual:
+			flush(&e)
+			if e.Text == "" {
+				continue
+			}
+			if len(out) > 0 && out[len(out)-1].Op == DiffEqual {
+				out[len(out)-1].Text += e.Text
+			} else {
+				out = append(out, e)
+			}
+		}
+	}
+	if runeLevel && del.Len() > 0 && ins.Len() > 0 {
+		tail := DiffEdit{Op: DiffEqual}
+		flush(&tail)
+		if tail.Text != "" {
+			out = append(out, tail)
+		}
+	} else {
+		flush(nil)
+	}
+	if !runeLevel {
+		return out
+	}
+
+	changes := false
+	for k := 1; k < len(out)-1; k++ {
+		prev, cur, next := out[k-1], out[k], out[k+1]
+		if prev.Op != DiffEqual || next.Op != DiffEqual || cur.Op == DiffEqual {
+			continue
+		}
+		if strings.HasSuffix(cur.Text, prev.Text) {
+			out[k].Text = prev.Text + cur.Text[:len(cur.Text)-len(prev.Text)]
+			out[k+1].Text = prev.Text + next.Text
+			out = append(out[:k-1], out[k:]...)
+			changes = true
+		} else if strings.HasPrefix(cur.Text, next.Text) {
+			out[k-1].Text += next.Text
+			out[k].Text = cur.Text[len(next.Text):] + next.Text
+			out = append(out[:k+1], out[k+2:]...)
+			changes = true
+		}
+	}
+	if changes {
+		return diffMerge(out, runeLevel)
+	}
+	return out
+}
+
+// diffCleanupLossless slides every single edit between two equalities to the position where
+// its ends best align with word and line boundaries.
+func diffCleanupLossless(edits []DiffEdit) []DiffEdit {
+	for k := 1; k < len(edits)-1; k++ {
+		if edits[k-1].Op != DiffEqual || edits[k+1].Op != DiffEqual || edits[k].Op == DiffEqual {
+			continue
+		}
+		eq1, edit, eq2 := edits[k-1].Text, edits[k].Text, edits[k+1].Text
+		if n := diffCommonSuffix(eq1, edit); n > 0 {
+			common := edit[len(edit)-n:]
+			eq1 = eq1[:len(eq1)-n]
+			edit = common + edit[:len(edit)-n]
+			eq2 = common + eq2
+		}
+		bestEq1, bestEdit, bestEq2 := eq1, edit, eq2
+		bestScore := diffBoundaryScore(eq1, edit) + diffBoundaryScore(edit, eq2)
+		for eq2 != "" {
+			r1, n1 := utf8.DecodeRuneInString(edit)
+			r2, n2 := utf8.DecodeRuneInString(eq2)
+			if r1 != r2 || n1 != n2 {
+				break
+			}
+			eq1 += edit[:n1]
+			edit = edit[n1:] + eq2[:n2]
+			eq2 = eq2[n2:]
+			// >= favours the last position, so edits end at the boundary rather than start after it
+			if score := diffBoundaryScore(eq1, edit) + diffBoundaryScore(edit, eq2); score >= bestScore {
+				bestScore = score
+				bestEq1, bestEdit, bestEq2 = eq1, edit, eq2
+			}
+		}
+		if edits[k-1].Text == bestEq1 {
+			continue
+		}
+		edits[k-1].Text, edits[k].Text, edits[k+1].Text = bestEq1, bestEdit, bestEq2
+		if bestEq1 == "" {
+			edits = append(edits[:k-1], edits[k:]...)
+			k--
+		}
+		if bestEq2 == "" {
+			edits = append(edits[:k+1], edits[k+2:]...)
+		}
+	}
+	return edits
+}
+
+// diffBoundaryScore rates the boundary between one and two: 6 at either end of the text,
+// 5 at a blank line, 4 at a line break, 3 at the end of a sentence, 2 at whitespace,
+// 1 at other non-alphanumeric runes and 0 inside a word.
+func diffBoundaryScore(one, two string) int {
+	if one == "" || two == "" {
+		return 6
+	}
+	r1, _ := utf8.DecodeLastRuneInString(one)
+	r2, _ := utf8.DecodeRuneInString(two)
+	nonAlnum1 := !unicode.IsLetter(r1) && !unicode.IsDigit(r1)
+	nonAlnum2 := !unicode.IsLetter(r2) && !unicode.IsDigit(r2)
+	space1 := nonAlnum1 && unicode.IsSpace(r1)
+	space2 := nonAlnum2 && unicode.IsSpace(r2)
+	lineBreak1 := space1 && (r1 == '\n' || r1 == '\r')
+	lineBreak2 := space2 && (r2 == '\n' || r2 == '\r')
+	switch {
+	case lineBreak1 && strings.HasSuffix(strings.TrimRight(one, "\r"), "\n\n"),
+		lineBreak2 && strings.HasPrefix(strings.TrimLeft(two, "\r"), "\n\n"):
+		return 5
+	case lineBreak1 || lineBreak2:
+		return 4
+	case nonAlnum1 && !space1 && space2:
+		return 3
+	case space1 || space2:
+		return 2
+	case nonAlnum1 || nonAlnum2:
+		return 1
+	}
+	return 0
+}
+
+// diffCommonPrefix returns the length in bytes of the common prefix of a and b, ending on a rune boundary.
+func diffCommonPrefix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	for n > 0 && n < len(a) && !utf8.RuneStart(a[n]) {
+		n--
+	}
+	return n
+}
+
+// diffCommonSuffix returns the length in bytes of the common suffix of a and b, starting on a rune boundary.
+func diffCommonSuffix(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	for n > 0 && !utf8.RuneStart(a[len(a)-n]) {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,135 @@
+package stringutils
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// ANSI escape sequences used by DiffANSI.
+const (
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiReset = "\x1b[0m"
+)
+
+// DiffUnified Renders the change described by the edits as hunks of a unified diff of the lines,
+// with up to context unchanged lines around every change. Equal texts render as "".
+//  stringutils.DiffUnified(stringutils.DiffLines("a\nb\nc\n", "a\nB\nc\n"), 1) = "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"
+func DiffUnified(edits []DiffEdit, context int) string {
+	if context < 0 {
+		context = 0
+	}
+	type line struct {
+		op   DiffOp
+		text string
+	}
+	var lines []line
+	// the lines are not cleaned up semantically, unchanged lines between changes stay context
+	a, b := DiffSource(edits), DiffTarget(edits)
+	for _, e := range diffTokens(splitLines(a), splitLines(b), false) {
+		for _, l := range splitLines(e.Text) {
+			lines = append(lines, line{e.Op, l})
+		}
+	}
+	// before[k] holds the number of source and target lines before lines[k]
+	before := make([][2]int, len(lines)+1)
+	for k, l := range lines {
+		before[k+1] = before[k]
+		if l.op != DiffInsert {
+			before[k+1][0]++
+		}
+		if l.op != DiffDelete {
+			before[k+1][1]++
+		}
+	}
+	var out strings.Builder
+	for i := 0; i < len(lines); {
+		for i < len(lines) && lines[i].op == DiffEqual {
+			i++
+		}
+		if i == len(lines) {
+			break
+		}
+		start, end := maxInt(0, i-context), i
+		for end < len(lines) {
+			if lines[end].op != DiffEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(lines) && lines[run].op == DiffEqual {
+				run++
+			}
+			if run == len(lines) || run-end > 2*context {
+				end = minInt(end+context, len(lines))
+				break
+			}
+			end = run
+		}
+		out.WriteString("@@ -")
+		out.WriteString(unifiedRange(before[start][0], before[end][0]-before[start][0]))
+		out.WriteString(" +")
+		out.WriteString(unifiedRange(before[start][1], before[end][1]-before[start][1]))
+		out.WriteString(" @@\n")
+		for _, l := range lines[start:end] {
+			switch l.op {
+			case DiffEqual:
+				out.WriteByte(' ')
+			case DiffDelete:
+				out.WriteByte('-')
+			case DiffInsert:
+				out.WriteByte('+')
+			}
+			out.WriteString(l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return out.String()
+}
+
+// unifiedRange formats the range of a hunk header, an empty range names the line before it.
+func unifiedRange(before, n int) string {
+	if n == 0 {
+		return strconv.Itoa(before) + ",0"
+	}
+	return strconv.Itoa(before+1) + "," + strconv.Itoa(n)
+}
+
+// DiffANSI Renders the edits inline for a terminal, deleted text in red and inserted text in green.
+//  stringutils.DiffANSI(stringutils.Diff("cat", "cut")) = "c\x1b[31ma\x1b[0m\x1b[32mu\x1b[0mt"
+func DiffANSI(edits []DiffEdit) string {
+	var b strings.Builder
+	for _, e := range edits {
+		switch e.Op {
+		case DiffEqual:
+			b.WriteString(e.Text)
+		case DiffDelete:
+			b.WriteString(ansiRed + e.Text + ansiReset)
+		case DiffInsert:
+			b.WriteString(ansiGreen + e.Text + ansiReset)
+		}
+	}
+	return b.String()
+}
+
+// DiffHTML Renders the edits as HTML, deleted text in <del> and inserted text in <ins> elements.
+// All text is escaped.
+//  stringutils.DiffHTML(stringutils.Diff("a<b", "a>b")) = "a<del>&lt;</del><ins>&gt;</ins>b"
+func DiffHTML(edits []DiffEdit) string {
+	var b strings.Builder
+	for _, e := range edits {
+		switch e.Op {
+		case DiffEqual:
+			b.WriteString(html.EscapeString(e.Text))
+		case DiffDelete:
+			b.WriteString("<del>" + html.EscapeString(e.Text) + "</del>")
+		case DiffInsert:
+			b.WriteString("<ins>" + html.EscapeString(e.Text) + "</ins>")
+		}
+	}
+	return b.String()
+}
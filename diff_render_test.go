@@ -0,0 +1,76 @@
+package stringutils
+
+import "testing"
+
+func TestDiffUnified(t *testing.T) {
+	type args struct {
+		edits   []DiffEdit
+		context int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{nil, 3}, ""},
+		{"equal", args{DiffLines("a\nb\n", "a\nb\n"), 3}, ""},
+		{"change", args{DiffLines("a\nb\nc\n", "a\nB\nc\n"), 1}, "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"no context", args{DiffLines("a\nb\nc\n", "a\nB\nc\n"), 0}, "@@ -2,1 +2,1 @@\n-b\n+B\n"},
+		{"insert into empty", args{Diff("", "a\n"), 3}, "@@ -0,0 +1,1 @@\n+a\n"},
+		{"no newline", args{Diff("a\nb", "a\nc"), 3}, "@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"},
+		{"two hunks", args{DiffLines("1\n2\n3\n4\n5\n6\n7\n8\n", "1\nx\n3\n4\n5\n6\ny\n8\n"), 1},
+			"@@ -1,3 +1,3 @@\n 1\n-2\n+x\n 3\n@@ -6,3 +6,3 @@\n 6\n-7\n+y\n 8\n"},
+		{"merged hunk", args{DiffLines("1\n2\n3\n4\n5\n", "1\nx\n3\ny\n5\n"), 1},
+			"@@ -1,5 +1,5 @@\n 1\n-2\n+x\n 3\n-4\n+y\n 5\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffUnified(tt.args.edits, tt.args.context); got != tt.want {
+				t.Errorf("DiffUnified() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffANSI(t *testing.T) {
+	type args struct {
+		edits []DiffEdit
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{nil}, ""},
+		{"cat,cut", args{Diff("cat", "cut")}, "c\x1b[31ma\x1b[0m\x1b[32mu\x1b[0mt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffANSI(tt.args.edits); got != tt.want {
+				t.Errorf("DiffANSI() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffHTML(t *testing.T) {
+	type args struct {
+		edits []DiffEdit
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{nil}, ""},
+		{"escaped", args{Diff("a<b", "a>b")}, "a<del>&lt;</del><ins>&gt;</ins>b"},
+		{"words", args{DiffWords("old & new", "new & new")}, "<del>old</del><ins>new</ins> &amp; new"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffHTML(tt.args.edits); got != tt.want {
+				t.Errorf("DiffHTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package stringutils
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DiffEdit
+	}{
+		{"empty", args{"", ""}, nil},
+		{"equal", args{"abc", "abc"}, []DiffEdit{{DiffEqual, "abc"}}},
+		{"insert all", args{"", "abc"}, []DiffEdit{{DiffInsert, "abc"}}},
+		{"delete all", args{"abc", ""}, []DiffEdit{{DiffDelete, "abc"}}},
+		{"delete suffix", args{"abc", "ab"}, []DiffEdit{{DiffEqual, "ab"}, {DiffDelete, "c"}}},
+		{"insert middle", args{"ac", "abc"}, []DiffEdit{{DiffEqual, "a"}, {DiffInsert, "b"}, {DiffEqual, "c"}}},
+		{"kitten,sitting", args{"kitten", "sitting"}, []DiffEdit{
+			{DiffDelete, "k"}, {DiffInsert, "s"}, {DiffEqual, "itt"}, {DiffDelete, "en"}, {DiffInsert, "ing"}}},
+		{"word boundary", args{"The cat sat.", "The dog sat."}, []DiffEdit{
+			{DiffEqual, "The "}, {DiffDelete, "cat"}, {DiffInsert, "dog"}, {DiffEqual, " sat."}}},
+		{"no common", args{"abcdef", "xyz"}, []DiffEdit{{DiffDelete, "abcdef"}, {DiffInsert, "xyz"}}},
+		{"runes", args{"日本語", "日本人"}, []DiffEdit{{DiffEqual, "日本"}, {DiffDelete, "語"}, {DiffInsert, "人"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Diff(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() string {
+		b := make([]rune, r.Intn(30))
+		for i := range b {
+			b[i] = []rune("ab c\nд")[r.Intn(6)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 1000; i++ {
+		a, b := random(), random()
+		for _, diff := range []func(a, b string) []DiffEdit{Diff, DiffWords, DiffLines} {
+			edits := diff(a, b)
+			if got := DiffSource(edits); got != a {
+				t.Fatalf("DiffSource(%q) = %q, want %q", edits, got, a)
+			}
+			if got := DiffTarget(edits); got != b {
+				t.Fatalf("DiffTarget(%q) = %q, want %q", edits, got, b)
+			}
+		}
+	}
+}
+
+func TestDiffWords(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DiffEdit
+	}{
+		{"empty", args{"", ""}, nil},
+		{"replace word", args{"the quick fox", "the slow fox"}, []DiffEdit{
+			{DiffEqual, "the "}, {DiffDelete, "quick"}, {DiffInsert, "slow"}, {DiffEqual, " fox"}}},
+		{"whole words", args{"the quick fox", "the quack fox"}, []DiffEdit{
+			{DiffEqual, "the "}, {DiffDelete, "quick"}, {DiffInsert, "quack"}, {DiffEqual, " fox"}}},
+		{"insert word", args{"a b", "a x b"}, []DiffEdit{{DiffEqual, "a "}, {DiffInsert, "x "}, {DiffEqual, "b"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffWords(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffWords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DiffEdit
+	}{
+		{"empty", args{"", ""}, nil},
+		{"delete line", args{"a\nb\nc\n", "a\nc\n"}, []DiffEdit{{DiffEqual, "a\n"}, {DiffDelete, "b\n"}, {DiffEqual, "c\n"}}},
+		{"no newline", args{"a\nb", "a\nc"}, []DiffEdit{{DiffEqual, "a\n"}, {DiffDelete, "b"}, {DiffInsert, "c"}}},
+		{"large", args{strings.Repeat("x\n", 500) + "y\n", "y\n" + strings.Repeat("x\n", 500)}, []DiffEdit{
+			{DiffInsert, "y\n"}, {DiffEqual, strings.Repeat("x\n", 500)}, {DiffDelete, "y\n"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffLines(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffLines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffCleanupSemantic(t *testing.T) {
+	type args struct {
+		edits []DiffEdit
+	}
+	tests := []struct {
+		name string
+		args args
+		want []DiffEdit
+	}{
+		{"empty", args{nil}, nil},
+		{"no elimination", args{[]DiffEdit{{DiffDelete, "ab"}, {DiffInsert, "cd"}, {DiffEqual, "12"}, {DiffDelete, "e"}}},
+			[]DiffEdit{{DiffDelete, "ab"}, {DiffInsert, "cd"}, {DiffEqual, "12"}, {DiffDelete, "e"}}},
+		{"simple elimination", args{[]DiffEdit{{DiffDelete, "a"}, {DiffEqual, "b"}, {DiffDelete, "c"}}},
+			[]DiffEdit{{DiffDelete, "abc"}, {DiffInsert, "b"}}},
+		{"backpass elimination", args{[]DiffEdit{{DiffDelete, "ab"}, {DiffEqual, "cd"}, {DiffDelete, "e"}, {DiffEqual, "f"}, {DiffInsert, "g"}}},
+			[]DiffEdit{{DiffDelete, "abcdef"}, {DiffInsert, "cdfg"}}},
+		{"word boundaries", args{[]DiffEdit{{DiffEqual, "The c"}, {DiffDelete, "ow and the c"}, {DiffEqual, "at."}}},
+			[]DiffEdit{{DiffEqual, "The "}, {DiffDelete, "cow and the "}, {DiffEqual, "cat."}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffCleanupSemantic(tt.args.edits); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffCleanupSemantic() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
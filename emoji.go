@@ -0,0 +1,103 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	emojiTextSelector  = 0xFE0E
+	emojiStyleSelector = 0xFE0F
+	keycapMark         = 0x20E3
+)
+
+// isEmojiCluster reports whether the grapheme cluster is an emoji or an emoji sequence of UTS #51:
+// a character shown as emoji by default or with the emoji variation selector or a skin tone
+// modifier, a keycap, a flag, or a ZWJ or tag sequence starting with one of those.
+func isEmojiCluster(c string) bool {
+	r, n := utf8.DecodeRuneInString(c)
+	next, _ := utf8.DecodeRuneInString(c[n:])
+	props := emojiPropertiesOf(r)
+	switch {
+	case props&emojiCharacter == 0 || next == emojiTextSelector:
+		return false
+	case r < utf8.RuneSelf:
+		// digits, "#" and "*" are emoji only as keycaps
+		return next == keycapMark || strings.HasPrefix(c[n:], string(rune(emojiStyleSelector))+string(rune(keycapMark)))
+	case props&emojiPresentation != 0 || next == emojiStyleSelector:
+		return true
+	}
+	return props&emojiModifierBase != 0 && emojiPropertiesOf(next)&emojiModifier != 0
+}
+
+// emojiSpans returns the byte offsets of the start and end of every emoji sequence of s.
+func emojiSpans(s string) [][2]int {
+	if isASCII(s) {
+		return nil
+	}
+	var spans [][2]int
+	bounds := graphemeBoundaries(s)
+	for i := 1; i < len(bounds); i++ {
+		if isEmojiCluster(s[bounds[i-1]:bounds[i]]) {
+			spans = append(spans, [2]int{bounds[i-1], bounds[i]})
+		}
+	}
+	return spans
+}
+
+// ContainsEmoji Checks if s contains an emoji.
+//  stringutils.ContainsEmoji("")        = false
+//  stringutils.ContainsEmoji("abc 123") = false
+//  stringutils.ContainsEmoji("hi 😀")   = true
+//  stringutils.ContainsEmoji("©")       = false
+//  stringutils.ContainsEmoji("©\uFE0F") = true
+func ContainsEmoji(s string) bool {
+	return len(emojiSpans(s)) > 0
+}
+
+// CountEmoji Counts the emoji of s, a sequence such as a family, a flag or a keycap counts once.
+//  stringutils.CountEmoji("")                    = 0
+//  stringutils.CountEmoji("😀😀")                = 2
+//  stringutils.CountEmoji("👨\u200D👩\u200D👧")  = 1
+//  stringutils.CountEmoji("🇫🇷🇩🇪")                = 2
+//  stringutils.CountEmoji("1\uFE0F\u20E3 and 1") = 1
+//  stringutils.CountEmoji("👍🏽")                = 1
+func CountEmoji(s string) int {
+	return len(emojiSpans(s))
+}
+
+// StripEmoji Returns s without its emoji, sequences are removed whole.
+//  stringutils.StripEmoji("")                     = ""
+//  stringutils.StripEmoji("hi 😀!")               = "hi !"
+//  stringutils.StripEmoji("a👨\u200D👩\u200D👧b") = "ab"
+func StripEmoji(s string) string {
+	spans := emojiSpans(s)
+	if len(spans) == 0 {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	last := 0
+	for _, sp := range spans {
+		b.WriteString(s[last:sp[0]])
+		last = sp[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// ExtractEmoji Returns the emoji of s in order, sequences whole.
+//  stringutils.ExtractEmoji("")                   = []
+//  stringutils.ExtractEmoji("a😀b🇫🇷")             = ["😀" "🇫🇷"]
+//  stringutils.ExtractEmoji("👍🏽 #\uFE0F\u20E3") = ["👍🏽" "#\uFE0F\u20E3"]
+func ExtractEmoji(s string) []string {
+	spans := emojiSpans(s)
+	if len(spans) == 0 {
+		return nil
+	}
+	emoji := make([]string, len(spans))
+	for i, sp := range spans {
+		emoji[i] = s[sp[0]:sp[1]]
+	}
+	return emoji
+}
@@ -0,0 +1,87 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "abc 123 #*", 0},
+		{"two", "😀😀", 2},
+		{"text", "hi 😀 there", 1},
+		{"zwj family", "\U0001F468\u200D\U0001F469\u200D\U0001F467", 1},
+		{"zwj heart on fire", "❤\uFE0F\u200D\U0001F525", 1},
+		{"flags", "\U0001F1EB\U0001F1F7\U0001F1E9\U0001F1EA", 2},
+		{"tag flag", "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F", 1},
+		{"keycap", "1\uFE0F\u20E3 and 1", 1},
+		{"keycap without selector", "#\u20E3", 1},
+		{"skin tone", "\U0001F44D\U0001F3FD", 1},
+		{"text default with skin tone", "☝\U0001F3FB", 1},
+		{"lone skin tone", "\U0001F3FD", 1},
+		{"text default", "© ™", 0},
+		{"emoji selector", "©\uFE0F", 1},
+		{"text selector", "⌚\uFE0E", 0},
+		{"emoji default", "⌚", 1},
+		{"zwj after letter", "a\u200D😀", 1},
+		{"cjk", "日本語", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountEmoji(tt.s); got != tt.want {
+				t.Errorf("CountEmoji() = %v, want %v", got, tt.want)
+			}
+			if got := ContainsEmoji(tt.s); got != (tt.want > 0) {
+				t.Errorf("ContainsEmoji() = %v, want %v", got, tt.want > 0)
+			}
+		})
+	}
+}
+
+func TestStripEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"none", "abc ©", "abc ©"},
+		{"one", "hi 😀!", "hi !"},
+		{"sequence", "a\U0001F468\u200D\U0001F469\u200D\U0001F467b", "ab"},
+		{"flag and keycap", "\U0001F1EB\U0001F1F7 #\uFE0F\u20E3 1", "  1"},
+		{"skin tone", "ok\U0001F44D\U0001F3FD", "ok"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripEmoji(tt.s); got != tt.want {
+				t.Errorf("StripEmoji() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractEmoji(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"none", "abc", nil},
+		{"several", "a😀b\U0001F1EB\U0001F1F7", []string{"😀", "\U0001F1EB\U0001F1F7"}},
+		{"sequences", "\U0001F44D\U0001F3FD #\uFE0F\u20E3", []string{"\U0001F44D\U0001F3FD", "#\uFE0F\u20E3"}},
+		{"zwj", "x\U0001F468\u200D\U0001F4BBy", []string{"\U0001F468\u200D\U0001F4BB"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractEmoji(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractEmoji() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Package escape escapes and unescapes strings for Java, JSON, ECMAScript, XML, HTML and CSV,
+// following the semantics of Apache Commons Text's StringEscapeUtils. Every format is a
+// Translator built from smaller translators, which can be combined with Aggregate and applied
+// to a string with Translate or to a stream with NewWriter.
+package escape
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrShortInput is returned by a Translator which needs more input than it was given to
+// decide on the translation of the text at the start of its input.
+var ErrShortInput = errors.New("escape: short input")
+
+// ErrInvalidEscape means an escape sequence is malformed, e.g. a "\u" with less than four hex digits.
+var ErrInvalidEscape = errors.New("escape: invalid escape sequence")
+
+// Translator translates text piece by piece.
+type Translator interface {
+	// Translate writes the translation of the text at the start of s to w and returns the number
+	// of bytes of s it translated, 0 if it does not translate the text at the start of s. When
+	// final is false s may continue, and ErrShortInput asks to be called again with more input.
+	Translate(w io.Writer, s string, final bool) (int, error)
+}
+
+// Translate Returns s translated by t. Text which no translator translates is copied unchanged.
+//  escape.Translate(escape.JavaEscaper, "He didn't say, \"Stop!\"") = "He didn't say, \\\"Stop!\\\"", nil
+func Translate(t Translator, s string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(s))
+	_, err := translate(&b, t, s, true)
+	return b.String(), err
+}
+
+// translate applies t to s up to the end or, unless final is set, up to text which needs more
+// input, and returns the number of bytes consumed.
+func translate(w io.Writer, t Translator, s string, final bool) (int, error) {
+	i := 0
+	for i < len(s) {
+		if !final && !utf8.FullRuneInString(s[i:]) {
+			break
+		}
+		n, err := t.Translate(w, s[i:], final)
+		if err == ErrShortInput && !final {
+			break
+		}
+		if err != nil {
+			return i, err
+		}
+		if n == 0 {
+			_, n = utf8.DecodeRuneInString(s[i:])
+			if _, err := io.WriteString(w, s[i:i+n]); err != nil {
+				return i, err
+			}
+		}
+		i += n
+	}
+	return i, nil
+}
+
+// translateWriter translates the text written to it.
+type translateWriter struct {
+	w       io.Writer
+	t       Translator
+	pending []byte
+	buf     bytes.Buffer
+}
+
+// NewWriter Returns a writer which translates the text written to it with t and writes the
+// result to w. Text a translator cannot translate yet, such as an escape sequence split between
+// two writes, is held back until the next write. Close translates and writes the held back text,
+// it does not close w.
+func NewWriter(w io.Writer, t Translator) io.WriteCloser {
+	return &translateWriter{w: w, t: t}
+}
+
+// Write implements io.Writer.
+func (tw *translateWriter) Write(p []byte) (int, error) {
+	if err := tw.flush(append(tw.pending, p...), false); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close implements io.Closer.
+func (tw *translateWriter) Close() error {
+	return tw.flush(tw.pending, true)
+}
+
+func (tw *translateWriter) flush(p []byte, final bool) error {
+	tw.buf.Reset()
+	n, err := translate(&tw.buf, tw.t, string(p), final)
+	tw.pending = append(tw.pending[:0], p[n:]...)
+	if err != nil {
+		return err
+	}
+	_, err = tw.w.Write(tw.buf.Bytes())
+	return err
+}
+
+// aggregate applies the first of its translators which translates the input.
+type aggregate []Translator
+
+// Aggregate Returns a translator which tries the translators in order and applies the first one
+// which translates the text at the start of the input.
+func Aggregate(translators ...Translator) Translator {
+	return aggregate(translators)
+}
+
+// Translate implements Translator.
+func (a aggregate) Translate(w io.Writer, s string, final bool) (int, error) {
+	for _, t := range a {
+		if n, err := t.Translate(w, s, final); n > 0 || err != nil {
+			return n, err
+		}
+	}
+	return 0, nil
+}
+
+// lookup translates the strings of a map, preferring the longest match.
+type lookup struct {
+	m                 map[string]string
+	prefixes          map[string]bool
+	shortest, longest int
+}
+
+// Lookup Returns a translator which replaces the keys of m by their values, the longest key
+// matching the start of the input wins.
+//  escape.Translate(escape.Lookup(map[string]string{"&": "&amp;"}), "a&b") = "a&amp;b", nil
+func Lookup(m map[string]string) Translator {
+	l := &lookup{m: make(map[string]string, len(m)), prefixes: make(map[string]bool), shortest: -1}
+	for k, v := range m {
+		if k == "" {
+			continue
+		}
+		l.m[k] = v
+		for i := 1; i < len(k); i++ {
+			l.prefixes[k[:i]] = true
+		}
+		if l.shortest < 0 || len(k) < l.shortest {
+			l.shortest = len(k)
+		}
+		if len(k) > l.longest {
+			l.longest = len(k)
+		}
+	}
+	return l
+}
+
+// Translate implements Translator.
+func (l *lookup) Translate(w io.Writer, s string, final bool) (int, error) {
+	if !final && len(s) < l.longest && l.prefixes[s] {
+		return 0, ErrShortInput
+	}
+	for n := minInt(l.longest, len(s)); n >= l.shortest && n > 0; n-- {
+		if v, ok := l.m[s[:n]]; ok {
+			_, err := io.WriteString(w, v)
+			return n, err
+		}
+	}
+	return 0, nil
+}
+
+// invert returns the map from the values of m to its keys.
+func invert(m map[string]string) map[string]string {
+	inv := make(map[string]string, len(m))
+	for k, v := range m {
+		inv[v] = k
+	}
+	return inv
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,82 @@
+package escape
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	amp := Lookup(map[string]string{"&": "&amp;", "&&": "and"})
+	tests := []struct {
+		name    string
+		t       Translator
+		s       string
+		want    string
+		wantErr error
+	}{
+		{"empty", amp, "", "", nil},
+		{"no match", amp, "abc", "abc", nil},
+		{"match", amp, "a&b", "a&amp;b", nil},
+		{"longest match", amp, "a&&&b", "aand&amp;b", nil},
+		{"multi-byte", amp, "é&ü", "é&amp;ü", nil},
+		{"invalid utf-8", amp, "\xff&", "\xff&amp;", nil},
+		{"aggregate order", Aggregate(Lookup(map[string]string{"a": "1"}), Lookup(map[string]string{"a": "2", "b": "3"})), "ab", "13", nil},
+		{"error", UnicodeUnescaper{}, `a\u12`, "a", ErrInvalidEscape},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Translate(tt.t, tt.s)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("Translate() = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		t      Translator
+		chunks []string
+		want   string
+	}{
+		{"no writes", JavaEscaper, nil, ""},
+		{"one write", JavaEscaper, []string{"a\"b"}, `a\"b`},
+		{"split rune", JavaEscaper, []string{"caf\xc3", "\xa9!"}, `caf\u00E9!`},
+		{"split unicode escape", JavaUnescaper, []string{`caf\`, `u0`, `0E9!`}, "café!"},
+		{"split surrogate pair", JavaUnescaper, []string{`\uD83D`, `\uDE00`}, "\U0001F600"},
+		{"split octal escape", JavaUnescaper, []string{`\1`, `01`}, "A"},
+		{"split entity", HTML4Unescaper, []string{"caf&eac", "ute; &#2", "33;"}, "café é"},
+		{"split lookup at end", HTML4Unescaper, []string{"a&am"}, "a&am"},
+		{"whole input", CSVEscaper, []string{"tea,", " coffee"}, `"tea, coffee"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			w := NewWriter(&b, tt.t)
+			for _, c := range tt.chunks {
+				if n, err := w.Write([]byte(c)); n != len(c) || err != nil {
+					t.Fatalf("Write(%q) = %d, %v", c, n, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("NewWriter() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWriter_error(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b, JavaUnescaper)
+	if _, err := w.Write([]byte(`ok \u12z`)); err != ErrInvalidEscape {
+		t.Errorf("Write() error = %v, want %v", err, ErrInvalidEscape)
+	}
+	if got := b.String(); got != "" {
+		t.Errorf("NewWriter() wrote %q, want nothing", got)
+	}
+}
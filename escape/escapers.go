@@ -0,0 +1,250 @@
+package escape
+
+import (
+	"io"
+	"strings"
+)
+
+var (
+	basicEscape = map[string]string{`"`: "&quot;", "&": "&amp;", "<": "&lt;", ">": "&gt;"}
+	aposEscape  = map[string]string{"'": "&apos;"}
+	javaControl = map[string]string{"\b": `\b`, "\n": `\n`, "\t": `\t`, "\f": `\f`, "\r": `\r`}
+)
+
+// The translators of the formats, they may be combined into new ones with Aggregate.
+var (
+	// JavaEscaper escapes quotes, backslashes and control characters with backslash escapes
+	// and all other control characters and non-ASCII runes with "\uXXXX" escapes.
+	JavaEscaper = Aggregate(
+		Lookup(map[string]string{`"`: `\"`, `\`: `\\`}),
+		Lookup(javaControl),
+		UnicodeEscaper{Lo: 0x20, Hi: 0x7F, Outside: true},
+	)
+	// JavaUnescaper unescapes octal, unicode and backslash escapes, a lone backslash is dropped.
+	JavaUnescaper = Aggregate(
+		OctalUnescaper{},
+		UnicodeUnescaper{},
+		Lookup(invert(javaControl)),
+		Lookup(map[string]string{`\\`: `\`, `\"`: `"`, `\'`: "'", `\`: ""}),
+	)
+	// EcmaScriptEscaper is JavaEscaper which also escapes single quotes and slashes.
+	EcmaScriptEscaper = Aggregate(
+		Lookup(map[string]string{"'": `\'`, `"`: `\"`, `\`: `\\`, "/": `\/`}),
+		Lookup(javaControl),
+		UnicodeEscaper{Lo: 0x20, Hi: 0x7F, Outside: true},
+	)
+	// JSONEscaper escapes quotes, backslashes, slashes and control characters with backslash escapes
+	// and all other runes outside of printable ASCII, including DEL, with "\uXXXX" escapes.
+	JSONEscaper = Aggregate(
+		Lookup(map[string]string{`"`: `\"`, `\`: `\\`, "/": `\/`}),
+		Lookup(javaControl),
+		UnicodeEscaper{Lo: 0x20, Hi: 0x7E, Outside: true},
+	)
+	// XML10Escaper escapes the five XML entities, escapes the C1 control characters and drops the
+	// characters which are not allowed in XML 1.0, as well as invalid UTF-8.
+	XML10Escaper = Aggregate(
+		Lookup(basicEscape),
+		Lookup(aposEscape),
+		Lookup(xmlRemoved(0x0, 0x8, 0xB, 0xC, 0xE, 0x1F, 0xFFFE, 0xFFFF)),
+		NumericEntityEscaper{Lo: 0x7F, Hi: 0x84},
+		NumericEntityEscaper{Lo: 0x86, Hi: 0x9F},
+		invalidUTF8Remover{},
+	)
+	// XML11Escaper escapes the five XML entities and the control characters allowed in XML 1.1,
+	// and drops the characters which are not, as well as invalid UTF-8.
+	XML11Escaper = Aggregate(
+		Lookup(basicEscape),
+		Lookup(aposEscape),
+		Lookup(map[string]string{"\x00": "", "\x0B": "&#11;", "\x0C": "&#12;", "\uFFFE": "", "\uFFFF": ""}),
+		NumericEntityEscaper{Lo: 0x1, Hi: 0x8},
+		NumericEntityEscaper{Lo: 0xE, Hi: 0x1F},
+		NumericEntityEscaper{Lo: 0x7F, Hi: 0x84},
+		NumericEntityEscaper{Lo: 0x86, Hi: 0x9F},
+		invalidUTF8Remover{},
+	)
+	// XMLUnescaper unescapes the five XML entities and numeric character references.
+	XMLUnescaper = Aggregate(
+		Lookup(invert(basicEscape)),
+		Lookup(invert(aposEscape)),
+		NumericEntityUnescaper{},
+	)
+	// HTML4Escaper escapes all characters which have an HTML 4.01 entity.
+	HTML4Escaper = Aggregate(
+		Lookup(basicEscape),
+		Lookup(latin1Entities),
+		Lookup(html40ExtendedEntities),
+	)
+	// HTML4Unescaper unescapes the HTML 4.01 entities and numeric character references.
+	HTML4Unescaper = Aggregate(
+		Lookup(invert(basicEscape)),
+		Lookup(invert(latin1Entities)),
+		Lookup(invert(html40ExtendedEntities)),
+		NumericEntityUnescaper{},
+	)
+	// HTML5Escaper escapes the characters which are special in HTML5 text and attribute values,
+	// HTML5 documents are UTF-8 so all others are kept.
+	HTML5Escaper = Aggregate(
+		Lookup(basicEscape),
+		Lookup(aposEscape),
+	)
+	// CSVEscaper encloses a whole CSV value in double quotes if it contains a comma, a double quote
+	// or a line break, and doubles its double quotes. It translates only once all input is known.
+	CSVEscaper Translator = csvEscaper{}
+	// CSVUnescaper removes the enclosing double quotes of a whole CSV value and undoubles its double
+	// quotes. It translates only once all input is known.
+	CSVUnescaper Translator = csvUnescaper{}
+)
+
+// xmlRemoved returns a lookup dropping the runes of the inclusive ranges given as pairs of bounds.
+func xmlRemoved(bounds ...rune) map[string]string {
+	m := make(map[string]string)
+	for i := 0; i < len(bounds); i += 2 {
+		for r := bounds[i]; r <= bounds[i+1]; r++ {
+			m[string(r)] = ""
+		}
+	}
+	return m
+}
+
+// escape applies a translator which cannot fail.
+func escape(t Translator, s string) string {
+	s, _ = Translate(t, s)
+	return s
+}
+
+// EscapeJava Escapes s using Java string rules, see JavaEscaper.
+//  escape.EscapeJava("")                       = ""
+//  escape.EscapeJava("He didn't say, \"Stop!\"") = "He didn't say, \\\"Stop!\\\""
+//  escape.EscapeJava("tab\there")              = "tab\\there"
+//  escape.EscapeJava("café")                   = "caf\\u00E9"
+//  escape.EscapeJava("😀")                      = "\\uD83D\\uDE00"
+func EscapeJava(s string) string {
+	return escape(JavaEscaper, s)
+}
+
+// UnescapeJava Unescapes the Java escapes of s, see JavaUnescaper. A "\u" with less than four hex
+// digits is an ErrInvalidEscape.
+//  escape.UnescapeJava("")                 = "", nil
+//  escape.UnescapeJava("tab\\there")       = "tab\there", nil
+//  escape.UnescapeJava("caf\\u00E9")       = "café", nil
+//  escape.UnescapeJava("\\uD83D\\uDE00")   = "😀", nil
+//  escape.UnescapeJava("\\101\\0")         = "A\x00", nil
+//  escape.UnescapeJava("\\u12")            = "", ErrInvalidEscape
+func UnescapeJava(s string) (string, error) {
+	return Translate(JavaUnescaper, s)
+}
+
+// EscapeEcmaScript Escapes s using EcmaScript string rules, see EcmaScriptEscaper.
+//  escape.EscapeEcmaScript("He didn't say, \"Stop!\"") = "He didn\\'t say, \\\"Stop!\\\""
+//  escape.EscapeEcmaScript("</script>")               = "<\\/script>"
+func EscapeEcmaScript(s string) string {
+	return escape(EcmaScriptEscaper, s)
+}
+
+// UnescapeEcmaScript Unescapes the EcmaScript escapes of s, see UnescapeJava.
+func UnescapeEcmaScript(s string) (string, error) {
+	return Translate(JavaUnescaper, s)
+}
+
+// EscapeJSON Escapes s using JSON string rules, see JSONEscaper.
+//  escape.EscapeJSON("He didn't say, \"Stop!\"") = "He didn't say, \\\"Stop!\\\""
+//  escape.EscapeJSON("a/b\n")                    = "a\\/b\\n"
+//  escape.EscapeJSON("\x7f")                     = "\\u007F"
+func EscapeJSON(s string) string {
+	return escape(JSONEscaper, s)
+}
+
+// UnescapeJSON Unescapes the JSON escapes of s, see UnescapeJava.
+func UnescapeJSON(s string) (string, error) {
+	return Translate(JavaUnescaper, s)
+}
+
+// EscapeXML10 Escapes s for XML 1.0, see XML10Escaper.
+//  escape.EscapeXML10("\"bread\" & 'butter'") = "&quot;bread&quot; &amp; &apos;butter&apos;"
+//  escape.EscapeXML10("a\x00b\u0085c\u0086")   = "ab\u0085c&#134;"
+func EscapeXML10(s string) string {
+	return escape(XML10Escaper, s)
+}
+
+// EscapeXML11 Escapes s for XML 1.1, see XML11Escaper.
+//  escape.EscapeXML11("a\x00b\x01c") = "ab&#1;c"
+func EscapeXML11(s string) string {
+	return escape(XML11Escaper, s)
+}
+
+// UnescapeXML Unescapes the XML entities and numeric character references of s, see XMLUnescaper.
+//  escape.UnescapeXML("&lt;a&gt; &#233;&#xE9;") = "<a> éé"
+func UnescapeXML(s string) string {
+	return escape(XMLUnescaper, s)
+}
+
+// EscapeHTML4 Escapes s with HTML 4.01 entities, see HTML4Escaper.
+//  escape.EscapeHTML4("\"bread\" & butter") = "&quot;bread&quot; &amp; butter"
+//  escape.EscapeHTML4("café €5")           = "caf&eacute; &euro;5"
+func EscapeHTML4(s string) string {
+	return escape(HTML4Escaper, s)
+}
+
+// UnescapeHTML4 Unescapes the HTML 4.01 entities and numeric character references of s, see HTML4Unescaper.
+//  escape.UnescapeHTML4("caf&eacute; &euro;5 &#233;") = "café €5 é"
+//  escape.UnescapeHTML4("&unknown;")                = "&unknown;"
+func UnescapeHTML4(s string) string {
+	return escape(HTML4Unescaper, s)
+}
+
+// EscapeHTML5 Escapes the characters of s which are special in HTML5, see HTML5Escaper.
+//  escape.EscapeHTML5("<p class='x'>café & co</p>") = "&lt;p class=&apos;x&apos;&gt;café &amp; co&lt;/p&gt;"
+func EscapeHTML5(s string) string {
+	return escape(HTML5Escaper, s)
+}
+
+// EscapeCSV Escapes s as a CSV value, see CSVEscaper.
+//  escape.EscapeCSV("tea")           = "tea"
+//  escape.EscapeCSV("tea, coffee")   = "\"tea, coffee\""
+//  escape.EscapeCSV("say \"cheese\"") = "\"say \"\"cheese\"\"\""
+func EscapeCSV(s string) string {
+	return escape(CSVEscaper, s)
+}
+
+// UnescapeCSV Unescapes the CSV value s, see CSVUnescaper.
+//  escape.UnescapeCSV("tea")                     = "tea"
+//  escape.UnescapeCSV("\"tea, coffee\"")         = "tea, coffee"
+//  escape.UnescapeCSV("\"say \"\"cheese\"\"\"") = "say \"cheese\""
+//  escape.UnescapeCSV("\"tea\"")                 = "\"tea\""
+func UnescapeCSV(s string) string {
+	return escape(CSVUnescaper, s)
+}
+
+const csvSpecial = ",\"\r\n"
+
+type csvEscaper struct{}
+
+// Translate implements Translator.
+func (csvEscaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	if !final {
+		return 0, ErrShortInput
+	}
+	var err error
+	if strings.ContainsAny(s, csvSpecial) {
+		_, err = io.WriteString(w, `"`+strings.ReplaceAll(s, `"`, `""`)+`"`)
+	} else {
+		_, err = io.WriteString(w, s)
+	}
+	return len(s), err
+}
+
+type csvUnescaper struct{}
+
+// Translate implements Translator.
+func (csvUnescaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	if !final {
+		return 0, ErrShortInput
+	}
+	v := s
+	// a quoted value without special characters did not need its quotes and is kept as it is
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' && strings.ContainsAny(s[1:len(s)-1], csvSpecial) {
+		v = strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+	_, err := io.WriteString(w, v)
+	return len(s), err
+}
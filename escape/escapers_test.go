@@ -0,0 +1,287 @@
+package escape
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEscapeJava(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"plain", "abc", "abc"},
+		{"quotes", `He didn't say, "Stop!"`, `He didn't say, \"Stop!\"`},
+		{"backslash", `a\b`, `a\\b`},
+		{"slash", "</script>", "</script>"},
+		{"controls", "\b\n\t\f\r", `\b\n\t\f\r`},
+		{"other control", "\x00\x1f\x7f", "\\u0000\\u001F\x7f"},
+		{"non-ascii", "café", `caf\u00E9`},
+		{"supplementary", "\U0001F600", `\uD83D\uDE00`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeJava(tt.s); got != tt.want {
+				t.Errorf("EscapeJava() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeJava(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{"empty", "", "", nil},
+		{"plain", "abc", "abc", nil},
+		{"quotes", `He didn\'t say, \"Stop!\"`, `He didn't say, "Stop!"`, nil},
+		{"backslash", `a\\b`, `a\b`, nil},
+		{"escaped backslash before u", `\\u00E9`, `\u00E9`, nil},
+		{"controls", `\b\n\t\f\r`, "\b\n\t\f\r", nil},
+		{"unicode", `caf\u00E9`, "café", nil},
+		{"surrogate pair", `\uD83D\uDE00`, "\U0001F600", nil},
+		{"octal", `\101\0`, "A\x00", nil},
+		{"unknown escape", `\q`, "q", nil},
+		{"trailing backslash", `a\`, "a", nil},
+		{"invalid unicode", `\u12`, "", ErrInvalidEscape},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnescapeJava(tt.s)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnescapeJava() = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEscapeEcmaScript(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"quotes", `He didn't say, "Stop!"`, `He didn\'t say, \"Stop!\"`},
+		{"slash", "</script>", `<\/script>`},
+		{"non-ascii", "café\n", `caf\u00E9\n`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeEcmaScript(tt.s); got != tt.want {
+				t.Errorf("EscapeEcmaScript() = %q, want %q", got, tt.want)
+			}
+			if got, err := UnescapeEcmaScript(tt.want); got != tt.s || err != nil {
+				t.Errorf("UnescapeEcmaScript() = %q, %v, want %q", got, err, tt.s)
+			}
+		})
+	}
+}
+
+func TestEscapeJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"quotes", `He didn't say, "Stop!"`, `He didn't say, \"Stop!\"`},
+		{"slash", "a/b\n", `a\/b\n`},
+		{"delete", "\x7f", `\u007F`},
+		{"non-ascii", "café", `caf\u00E9`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeJSON(tt.s); got != tt.want {
+				t.Errorf("EscapeJSON() = %q, want %q", got, tt.want)
+			}
+			if got, err := UnescapeJSON(tt.want); got != tt.s || err != nil {
+				t.Errorf("UnescapeJSON() = %q, %v, want %q", got, err, tt.s)
+			}
+		})
+	}
+}
+
+func TestEscapeXML10(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"entities", `"bread" & 'butter' <b>`, "&quot;bread&quot; &amp; &apos;butter&apos; &lt;b&gt;"},
+		{"allowed controls", "a\tb\nc\rd", "a\tb\nc\rd"},
+		{"removed controls", "a\x00b\x0bc\x1fd", "abcd"},
+		{"c1 controls", "\x7f\u0084\u0085\u0086\u009f", "&#127;&#132;\u0085&#134;&#159;"},
+		{"non-characters", "a\ufffeb\uffffc", "abc"},
+		{"invalid utf-8", "a\xffb", "ab"},
+		{"non-ascii", "café", "café"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeXML10(tt.s); got != tt.want {
+				t.Errorf("EscapeXML10() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeXML11(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"entities", `"a" & 'b'`, "&quot;a&quot; &amp; &apos;b&apos;"},
+		{"null", "a\x00b", "ab"},
+		{"controls", "\x01\x08\x0b\x0c\x0e\x1f", "&#1;&#8;&#11;&#12;&#14;&#31;"},
+		{"allowed controls", "a\tb\nc\rd", "a\tb\nc\rd"},
+		{"c1 controls", "\x7f\u0085\u0086", "&#127;\u0085&#134;"},
+		{"non-characters", "a\ufffeb\uffffc", "abc"},
+		{"invalid utf-8", "a\xffb", "ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeXML11(tt.s); got != tt.want {
+				t.Errorf("EscapeXML11() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeXML(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"entities", "&quot;bread&quot; &amp; &apos;butter&apos; &lt;b&gt;", `"bread" & 'butter' <b>`},
+		{"numeric", "&#233;&#xE9;", "éé"},
+		{"html entity", "&eacute;", "&eacute;"},
+		{"double escaped", "&amp;lt;", "&lt;"},
+		{"unterminated", "&amp", "&amp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnescapeXML(tt.s); got != tt.want {
+				t.Errorf("UnescapeXML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeHTML4(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"basic", `"bread" & butter <b>`, "&quot;bread&quot; &amp; butter &lt;b&gt;"},
+		{"apostrophe", "don't", "don't"},
+		{"latin-1", "café\u00a0", "caf&eacute;&nbsp;"},
+		{"extended", "€ α → ♥", "&euro; &alpha; &rarr; &hearts;"},
+		{"no entity", "ā\U0001F600", "ā\U0001F600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeHTML4(tt.s); got != tt.want {
+				t.Errorf("EscapeHTML4() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeHTML4(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"basic", "&quot;bread&quot; &amp; butter &lt;b&gt;", `"bread" & butter <b>`},
+		{"named", "caf&eacute; &euro;5 &hearts;", "café €5 ♥"},
+		{"numeric", "&#233;&#x20AC;", "é€"},
+		{"unknown", "&unknown; &apos;", "&unknown; &apos;"},
+		{"no semicolon", "&eacute", "&eacute"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnescapeHTML4(tt.s); got != tt.want {
+				t.Errorf("UnescapeHTML4() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeHTML5(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"special", `<p class='x'>"café" & co</p>`, "&lt;p class=&apos;x&apos;&gt;&quot;café&quot; &amp; co&lt;/p&gt;"},
+		{"non-ascii", "€ \U0001F600", "€ \U0001F600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeHTML5(tt.s); got != tt.want {
+				t.Errorf("EscapeHTML5() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"plain", "tea", "tea"},
+		{"comma", "tea, coffee", `"tea, coffee"`},
+		{"quotes", `say "cheese"`, `"say ""cheese"""`},
+		{"line break", "a\nb", "\"a\nb\""},
+		{"carriage return", "a\rb", "\"a\rb\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeCSV(tt.s); got != tt.want {
+				t.Errorf("EscapeCSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescapeCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"plain", "tea", "tea"},
+		{"quoted comma", `"tea, coffee"`, "tea, coffee"},
+		{"quoted quotes", `"say ""cheese"""`, `say "cheese"`},
+		{"quoted line break", "\"a\nb\"", "a\nb"},
+		{"needless quotes", `"tea"`, `"tea"`},
+		{"single quote", `"`, `"`},
+		{"open quote", `"tea, coffee`, `"tea, coffee`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnescapeCSV(tt.s); got != tt.want {
+				t.Errorf("UnescapeCSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package escape
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// The parse errors of HTML5 character references, reported by HTML5Decoder.Decode.
+var (
+	ErrMissingSemicolon      = errors.New("escape: missing semicolon after character reference")
+	ErrUnknownReference      = errors.New("escape: unknown named character reference")
+	ErrNoDigits              = errors.New("escape: absence of digits in numeric character reference")
+	ErrNullReference         = errors.New("escape: null character reference")
+	ErrOutsideUnicode        = errors.New("escape: character reference outside unicode range")
+	ErrSurrogateReference    = errors.New("escape: surrogate character reference")
+	ErrNoncharacterReference = errors.New("escape: noncharacter character reference")
+	ErrControlReference      = errors.New("escape: control character reference")
+)
+
+// MalformedReference is a character reference which does not follow the HTML5 syntax.
+type MalformedReference struct {
+	// Offset is the byte offset of the reference in the input string, not in the decoded text.
+	Offset int
+	// Reference is the text of the reference, e.g. "&amp" or "&#x0;".
+	Reference string
+	// Err is the parse error of the reference, e.g. ErrMissingSemicolon.
+	Err error
+}
+
+// Error implements error.
+func (m MalformedReference) Error() string {
+	return fmt.Sprintf("%v: %q at offset %d", m.Err, m.Reference, m.Offset)
+}
+
+// Unwrap returns the parse error of the reference.
+func (m MalformedReference) Unwrap() error {
+	return m.Err
+}
+
+// HTML5Decoder decodes HTML5 character references as a browser does: named references from the
+// full HTML5 table, also the legacy ones without a semicolon, and decimal and hexadecimal
+// references, where the C1 controls stand for their windows-1252 characters and null, surrogate and
+// out of range code points for U+FFFD. Text which is not a reference is kept unchanged.
+type HTML5Decoder struct {
+	// Attribute decodes the text of an attribute value, where a named reference without its
+	// semicolon followed by "=" or an ASCII letter or digit is kept unchanged, e.g. in "?a=1&copy=2".
+	Attribute bool
+}
+
+// HTML5Unescaper is the translator decoding HTML5 character references in text.
+var HTML5Unescaper Translator = HTML5Decoder{}
+
+// Translate implements Translator.
+func (d HTML5Decoder) Translate(w io.Writer, s string, final bool) (int, error) {
+	if s == "" || s[0] != '&' {
+		return 0, nil
+	}
+	v, n, _, err := d.reference(s, final)
+	if n == 0 || err != nil {
+		return 0, err
+	}
+	_, err = io.WriteString(w, v)
+	return n, err
+}
+
+// Decode Returns s with its character references decoded, and the references which are malformed
+// in the order they occur. A reference may have more than one problem.
+//  escape.HTML5Decoder{}.Decode("caf&eacute; &amp &#x80;")   = "café & €", [{12 "&amp" ErrMissingSemicolon} {17 "&#x80;" ErrControlReference}]
+//  escape.HTML5Decoder{}.Decode("&foo;")                   = "&foo;", [{0 "&foo;" ErrUnknownReference}]
+//  escape.HTML5Decoder{Attribute: true}.Decode("?a=1&copy=2") = "?a=1&copy=2", []
+func (d HTML5Decoder) Decode(s string) (string, []MalformedReference) {
+	var b strings.Builder
+	b.Grow(len(s))
+	var malformed []MalformedReference
+	for i := 0; i < len(s); {
+		j := strings.IndexByte(s[i:], '&')
+		if j < 0 {
+			b.WriteString(s[i:])
+			break
+		}
+		b.WriteString(s[i : i+j])
+		i += j
+		v, n, problems, _ := d.reference(s[i:], true)
+		if n == 0 {
+			b.WriteByte('&')
+			i++
+			continue
+		}
+		for _, p := range problems {
+			malformed = append(malformed, MalformedReference{Offset: i, Reference: s[i : i+n], Err: p})
+		}
+		b.WriteString(v)
+		i += n
+	}
+	return b.String(), malformed
+}
+
+// UnescapeHTML5 Returns s with its HTML5 character references decoded, see HTML5Decoder.
+//  escape.UnescapeHTML5("caf&eacute; &amp; &#x1F600;") = "café & 😀"
+//  escape.UnescapeHTML5("&notit; &notin;")             = "¬it; ∉"
+//  escape.UnescapeHTML5("&#150; &#0;")                 = "– �"
+//  escape.UnescapeHTML5("&foo; & &#;")                 = "&foo; & &#;"
+func UnescapeHTML5(s string) string {
+	s, _ = HTML5Decoder{}.Decode(s)
+	return s
+}
+
+// reference decodes the character reference at the start of s, which starts with "&", and returns
+// the decoded text, the number of bytes it replaces, 0 if s does not start with a reference, and
+// its parse errors. A malformed reference which is not decoded is returned as it is.
+func (d HTML5Decoder) reference(s string, final bool) (v string, n int, problems []error, err error) {
+	if len(s) < 2 {
+		if !final {
+			return "", 0, nil, ErrShortInput
+		}
+		return "", 0, nil, nil
+	}
+	if s[1] == '#' {
+		return numericReference(s, final)
+	}
+	end := 1
+	for end < len(s) && isAlphanumeric(s[end]) {
+		end++
+	}
+	if end == len(s) && !final && end <= html5LongestEntity {
+		return "", 0, nil, ErrShortInput
+	}
+	if end < len(s) && s[end] == ';' {
+		end++
+	}
+	for n := minInt(end, html5LongestEntity+1); n > 1; n-- {
+		v, ok := html5Entities[s[1:n]]
+		if !ok {
+			continue
+		}
+		if s[n-1] == ';' {
+			return v, n, nil, nil
+		}
+		if d.Attribute && n < len(s) && (s[n] == '=' || isAlphanumeric(s[n])) {
+			return s[:n], n, nil, nil
+		}
+		return v, n, []error{ErrMissingSemicolon}, nil
+	}
+	if end > 2 && s[end-1] == ';' {
+		return s[:end], end, []error{ErrUnknownReference}, nil
+	}
+	return "", 0, nil, nil
+}
+
+// numericReference decodes the decimal or hexadecimal character reference at the start of s,
+// which starts with "&#".
+func numericReference(s string, final bool) (v string, n int, problems []error, err error) {
+	start, base := 2, rune(10)
+	if len(s) > 2 && (s[2] == 'x' || s[2] == 'X') {
+		start, base = 3, 16
+	}
+	end := start
+	r := rune(0)
+	for ; end < len(s); end++ {
+		d := hexValue(s[end])
+		if d < 0 || d >= base {
+			break
+		}
+		// larger values are all out of range, do not let them overflow
+		r = minRune(r*base+d, utf8.MaxRune+1)
+	}
+	if end == len(s) && !final {
+		return "", 0, nil, ErrShortInput
+	}
+	if end == start {
+		return s[:end], end, []error{ErrNoDigits}, nil
+	}
+	if end < len(s) && s[end] == ';' {
+		end++
+	} else {
+		problems = append(problems, ErrMissingSemicolon)
+	}
+	switch {
+	case r == 0:
+		problems, r = append(problems, ErrNullReference), utf8.RuneError
+	case r > utf8.MaxRune:
+		problems, r = append(problems, ErrOutsideUnicode), utf8.RuneError
+	case r >= 0xD800 && r <= 0xDFFF:
+		problems, r = append(problems, ErrSurrogateReference), utf8.RuneError
+	case r >= 0xFDD0 && r <= 0xFDEF || r&0xFFFE == 0xFFFE:
+		problems = append(problems, ErrNoncharacterReference)
+	case r == '\r' || (r < 0x20 || r >= 0x7F && r <= 0x9F) && !strings.ContainsRune("\t\n\f ", r):
+		problems = append(problems, ErrControlReference)
+		if c, ok := windows1252[r]; ok {
+			r = c
+		}
+	}
+	return string(r), end, problems, nil
+}
+
+// windows1252 maps the C1 controls which a numeric reference takes for windows-1252 bytes to the
+// characters of those bytes.
+var windows1252 = map[rune]rune{
+	0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†',
+	0x87: '‡', 0x88: 'ˆ', 0x89: '‰', 0x8A: 'Š', 0x8B: '‹', 0x8C: 'Œ',
+	0x8E: 'Ž', 0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”', 0x95: '•',
+	0x96: '–', 0x97: '—', 0x98: '˜', 0x99: '™', 0x9A: 'š', 0x9B: '›',
+	0x9C: 'œ', 0x9E: 'ž', 0x9F: 'Ÿ',
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// hexValue returns the value of a hex digit, -1 if c is not one.
+func hexValue(c byte) rune {
+	switch {
+	case c >= '0' && c <= '9':
+		return rune(c - '0')
+	case c >= 'a' && c <= 'f':
+		return rune(c-'a') + 10
+	case c >= 'A' && c <= 'F':
+		return rune(c-'A') + 10
+	}
+	return -1
+}
+
+func minRune(a, b rune) rune {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,126 @@
+package escape
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestHTML5Decoder_Decode(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute bool
+		s         string
+		want      string
+		wantErrs  []MalformedReference
+	}{
+		{"empty", false, "", "", nil},
+		{"no references", false, "a & b", "a & b", nil},
+		{"named", false, "caf&eacute; &amp; &hearts;", "café & ♥", nil},
+		{"two characters", false, "&NotEqualTilde;", "\u2242\u0338", nil},
+		{"legacy", false, "&amp &copy2", "& ©2", []MalformedReference{
+			{0, "&amp", ErrMissingSemicolon},
+			{5, "&copy", ErrMissingSemicolon},
+		}},
+		{"not legacy", false, "&hearts", "&hearts", nil},
+		{"longest legacy prefix", false, "&notit; &notin;", "¬it; ∉", []MalformedReference{{0, "&not", ErrMissingSemicolon}}},
+		{"unknown", false, "&foo; &1;", "&foo; &1;", []MalformedReference{
+			{0, "&foo;", ErrUnknownReference},
+			{6, "&1;", ErrUnknownReference},
+		}},
+		{"case sensitive", false, "&AMP;&Eacute;&EACUTE;", "&É&EACUTE;", []MalformedReference{{13, "&EACUTE;", ErrUnknownReference}}},
+		{"decimal", false, "&#233;&#128512;", "é\U0001F600", nil},
+		{"hex", false, "&#xE9;&#X1f600;", "é\U0001F600", nil},
+		{"numeric without semicolon", false, "&#233x", "éx", []MalformedReference{{0, "&#233", ErrMissingSemicolon}}},
+		{"no digits", false, "&#; &#x;", "&#; &#x;", []MalformedReference{
+			{0, "&#", ErrNoDigits},
+			{4, "&#x", ErrNoDigits},
+		}},
+		{"null", false, "&#0;", "�", []MalformedReference{{0, "&#0;", ErrNullReference}}},
+		{"outside unicode", false, "&#x110000;&#99999999999999999999;", "��", []MalformedReference{
+			{0, "&#x110000;", ErrOutsideUnicode},
+			{10, "&#99999999999999999999;", ErrOutsideUnicode},
+		}},
+		{"surrogate", false, "&#xD800;", "�", []MalformedReference{{0, "&#xD800;", ErrSurrogateReference}}},
+		{"noncharacter", false, "&#xFDD0;&#x1FFFE;", "﷐\U0001FFFE", []MalformedReference{
+			{0, "&#xFDD0;", ErrNoncharacterReference},
+			{8, "&#x1FFFE;", ErrNoncharacterReference},
+		}},
+		{"windows-1252", false, "&#128;&#x96;&#x9d;", "€–\u009d", []MalformedReference{
+			{0, "&#128;", ErrControlReference},
+			{6, "&#x96;", ErrControlReference},
+			{12, "&#x9d;", ErrControlReference},
+		}},
+		{"controls", false, "&#1;&#13;&#9;&#10;", "\x01\r\t\n", []MalformedReference{
+			{0, "&#1;", ErrControlReference},
+			{4, "&#13;", ErrControlReference},
+		}},
+		{"two problems", false, "&#0 ", "� ", []MalformedReference{
+			{0, "&#0", ErrMissingSemicolon},
+			{0, "&#0", ErrNullReference},
+		}},
+		{"ampersand at end", false, "a&", "a&", nil},
+		{"attribute", true, "?a=1&copy=2&copyx&copy;&copy.", "?a=1&copy=2&copyx©©.", []MalformedReference{{23, "&copy", ErrMissingSemicolon}}},
+		{"text", false, "?a=1&copy=2&copyx", "?a=1©=2©x", []MalformedReference{
+			{4, "&copy", ErrMissingSemicolon},
+			{11, "&copy", ErrMissingSemicolon},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotErrs := HTML5Decoder{Attribute: tt.attribute}.Decode(tt.s)
+			if got != tt.want || !reflect.DeepEqual(gotErrs, tt.wantErrs) {
+				t.Errorf("Decode() = %q, %v, want %q, %v", got, gotErrs, tt.want, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestHTML5Decoder_table(t *testing.T) {
+	for name, want := range html5Entities {
+		if got := UnescapeHTML5("&" + name); got != want {
+			t.Errorf("UnescapeHTML5(%q) = %q, want %q", "&"+name, got, want)
+		}
+	}
+}
+
+func TestMalformedReference_Error(t *testing.T) {
+	err := error(MalformedReference{Offset: 3, Reference: "&amp", Err: ErrMissingSemicolon})
+	if want := `escape: missing semicolon after character reference: "&amp" at offset 3`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if !errors.Is(err, ErrMissingSemicolon) {
+		t.Errorf("errors.Is(%v, ErrMissingSemicolon) = false", err)
+	}
+}
+
+func TestHTML5Unescaper_stream(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunks []string
+		want   string
+	}{
+		{"split name", []string{"caf&eac", "ute; &no", "tin;"}, "café ∉"},
+		{"split legacy", []string{"&amp", " &not", "it;"}, "& ¬it;"},
+		{"split numeric", []string{"&#", "x1F6", "00;&#23", "3"}, "\U0001F600é"},
+		{"ampersand at end", []string{"a&"}, "a&"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			w := NewWriter(&b, HTML5Unescaper)
+			for _, c := range tt.chunks {
+				if _, err := w.Write([]byte(c)); err != nil {
+					t.Fatalf("Write(%q) = %v", c, err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() = %v", err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("NewWriter() wrote %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package escape
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// UnicodeEscaper escapes runes as Java "\uXXXX" escapes, supplementary runes as a surrogate pair
+// of escapes. Invalid UTF-8 bytes are treated as U+FFFD.
+type UnicodeEscaper struct {
+	// Lo and Hi are the bounds of the runes to escape, inclusive.
+	Lo, Hi rune
+	// Outside escapes the runes outside of Lo and Hi instead of those between them.
+	Outside bool
+}
+
+// Translate implements Translator.
+func (e UnicodeEscaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	r, n := utf8.DecodeRuneInString(s)
+	if (r >= e.Lo && r <= e.Hi) == e.Outside {
+		return 0, nil
+	}
+	var err error
+	if r1, r2 := utf16.EncodeRune(r); r1 != utf8.RuneError {
+		_, err = io.WriteString(w, unicodeEscape(r1)+unicodeEscape(r2))
+	} else {
+		_, err = io.WriteString(w, unicodeEscape(r))
+	}
+	return n, err
+}
+
+// unicodeEscape returns the "\uXXXX" escape of a rune of the Basic Multilingual Plane.
+func unicodeEscape(r rune) string {
+	h := strings.ToUpper(strconv.FormatInt(int64(r), 16))
+	return `\u` + strings.Repeat("0", 4-len(h)) + h
+}
+
+// NumericEntityEscaper escapes runes as decimal numeric character references, e.g. "&#160;".
+type NumericEntityEscaper struct {
+	// Lo and Hi are the bounds of the runes to escape, inclusive.
+	Lo, Hi rune
+	// Outside escapes the runes outside of Lo and Hi instead of those between them.
+	Outside bool
+}
+
+// Translate implements Translator.
+func (e NumericEntityEscaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	r, n := utf8.DecodeRuneInString(s)
+	if (r >= e.Lo && r <= e.Hi) == e.Outside {
+		return 0, nil
+	}
+	_, err := io.WriteString(w, "&#"+strconv.Itoa(int(r))+";")
+	return n, err
+}
+
+// UnicodeUnescaper unescapes Java "\uXXXX" escapes, also with several u's or a "+" as in "\uu+00E9".
+// A pair of escaped surrogates is unescaped to one rune, an unpaired surrogate to U+FFFD. An escape
+// with less than four hex digits is an ErrInvalidEscape.
+type UnicodeUnescaper struct{}
+
+// Translate implements Translator.
+func (UnicodeUnescaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	r, n, err := parseUnicodeEscape(s, final)
+	if n == 0 || err != nil {
+		return 0, err
+	}
+	if utf16.IsSurrogate(r) {
+		r2, n2, err := parseUnicodeEscape(s[n:], final)
+		if err != nil {
+			return 0, err
+		}
+		if pair := utf16.DecodeRune(r, r2); n2 > 0 && pair != utf8.RuneError {
+			r, n = pair, n+n2
+		} else {
+			r = utf8.RuneError
+		}
+	}
+	_, err = io.WriteString(w, string(r))
+	return n, err
+}
+
+// parseUnicodeEscape parses the "\uXXXX" escape at the start of s, n is 0 if s does not start with one.
+func parseUnicodeEscape(s string, final bool) (r rune, n int, err error) {
+	if !final && (s == `\` || s == "") {
+		return 0, 0, ErrShortInput
+	}
+	if !strings.HasPrefix(s, `\u`) {
+		return 0, 0, nil
+	}
+	i := 2
+	for i < len(s) && s[i] == 'u' {
+		i++
+	}
+	if i < len(s) && s[i] == '+' {
+		i++
+	}
+	if len(s) < i+4 {
+		if !final && isHex(s[i:]) {
+			return 0, 0, ErrShortInput
+		}
+		return 0, 0, ErrInvalidEscape
+	}
+	v, err := strconv.ParseUint(s[i:i+4], 16, 16)
+	if err != nil {
+		return 0, 0, ErrInvalidEscape
+	}
+	return rune(v), i + 4, nil
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", rune(s[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// OctalUnescaper unescapes Java octal escapes from "\0" to "\377".
+type OctalUnescaper struct{}
+
+// Translate implements Translator.
+func (OctalUnescaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	if !final && s == `\` {
+		return 0, ErrShortInput
+	}
+	if len(s) < 2 || s[0] != '\\' || !isOctal(s[1]) {
+		return 0, nil
+	}
+	n := 2
+	if n < len(s) && isOctal(s[n]) {
+		n++
+		if s[1] <= '3' && n < len(s) && isOctal(s[n]) {
+			n++
+		}
+	}
+	if !final && n == len(s) && (n < 3 || (n == 3 && s[1] <= '3')) {
+		return 0, ErrShortInput
+	}
+	v, _ := strconv.ParseUint(s[1:n], 8, 32)
+	_, err := io.WriteString(w, string(rune(v)))
+	return n, err
+}
+
+func isOctal(c byte) bool {
+	return c >= '0' && c <= '7'
+}
+
+// NumericEntityUnescaper unescapes decimal and hexadecimal numeric character references such as
+// "&#233;" and "&#xE9;". The terminating semicolon is required, references to invalid code points
+// are left unchanged.
+type NumericEntityUnescaper struct{}
+
+// Translate implements Translator.
+func (NumericEntityUnescaper) Translate(w io.Writer, s string, final bool) (int, error) {
+	if !final && (s == "&" || s == "&#") {
+		return 0, ErrShortInput
+	}
+	if len(s) < 3 || s[0] != '&' || s[1] != '#' {
+		return 0, nil
+	}
+	start, base := 2, 10
+	if s[2] == 'x' || s[2] == 'X' {
+		start, base = 3, 16
+	}
+	end := start
+	for end < len(s) && isHex(s[end:end+1]) {
+		end++
+	}
+	if end == len(s) {
+		if !final {
+			return 0, ErrShortInput
+		}
+		return 0, nil
+	}
+	if s[end] != ';' {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(s[start:end], base, 32)
+	if err != nil || !utf8.ValidRune(rune(v)) {
+		return 0, nil
+	}
+	_, err = io.WriteString(w, string(rune(v)))
+	return end + 1, err
+}
+
+// invalidUTF8Remover drops invalid UTF-8 bytes, which cannot be represented in XML.
+type invalidUTF8Remover struct{}
+
+// Translate implements Translator.
+func (invalidUTF8Remover) Translate(w io.Writer, s string, final bool) (int, error) {
+	if r, n := utf8.DecodeRuneInString(s); r == utf8.RuneError && n == 1 {
+		return 1, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,127 @@
+package escape
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnicodeEscaper(t *testing.T) {
+	tests := []struct {
+		name string
+		e    UnicodeEscaper
+		s    string
+		want string
+	}{
+		{"inside", UnicodeEscaper{Lo: 'a', Hi: 'c'}, "abcd", `\u0061\u0062\u0063d`},
+		{"outside", UnicodeEscaper{Lo: 0x20, Hi: 0x7f, Outside: true}, "a\x01\u00e9", `a\u0001\u00E9`},
+		{"supplementary", UnicodeEscaper{Lo: 0x20, Hi: 0x7f, Outside: true}, "\U0001F600", `\uD83D\uDE00`},
+		{"invalid utf-8", UnicodeEscaper{Lo: 0x20, Hi: 0x7f, Outside: true}, "\xff", `\uFFFD`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Translate(tt.e, tt.s); got != tt.want {
+				t.Errorf("UnicodeEscaper = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumericEntityEscaper(t *testing.T) {
+	tests := []struct {
+		name string
+		e    NumericEntityEscaper
+		s    string
+		want string
+	}{
+		{"inside", NumericEntityEscaper{Lo: 0x80, Hi: 0xff}, "a\u00e9\u20ac", "a&#233;\u20ac"},
+		{"outside", NumericEntityEscaper{Lo: 0, Hi: 0x7f, Outside: true}, "a\u00e9\U0001F600", "a&#233;&#128512;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Translate(tt.e, tt.s); got != tt.want {
+				t.Errorf("NumericEntityEscaper = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnicodeUnescaper(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{"none", "abc", "abc", nil},
+		{"lower case", `\u00e9`, "\u00e9", nil},
+		{"upper case", `\u00E9`, "\u00e9", nil},
+		{"several u", `\uuu00E9`, "\u00e9", nil},
+		{"plus", `\u+00E9`, "\u00e9", nil},
+		{"surrogate pair", `\uD83D\uDE00`, "\U0001F600", nil},
+		{"unpaired high surrogate", `\uD83Dx`, "\ufffdx", nil},
+		{"unpaired low surrogate", `\uDE00`, "\ufffd", nil},
+		{"two high surrogates", `\uD83D\uD83D\uDE00`, "\ufffd\U0001F600", nil},
+		{"backslash", `\n`, `\n`, nil},
+		{"too short", `\u12`, "", ErrInvalidEscape},
+		{"not hex", `\u12g4`, "", ErrInvalidEscape},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Translate(UnicodeUnescaper{}, tt.s)
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("UnicodeUnescaper = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOctalUnescaper(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"none", "abc", "abc"},
+		{"one digit", `\0`, "\x00"},
+		{"two digits", `\12`, "\n"},
+		{"three digits", `\101`, "A"},
+		{"highest", `\377`, "ÿ"},
+		{"fourth digit kept", `\1012`, "A2"},
+		{"third digit after 4 kept", `\477`, "'7"},
+		{"not octal", `\8`, `\8`},
+		{"trailing backslash", `a\`, `a\`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Translate(OctalUnescaper{}, tt.s); got != tt.want {
+				t.Errorf("OctalUnescaper = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumericEntityUnescaper(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"none", "a & b", "a & b"},
+		{"decimal", "&#233;", "é"},
+		{"hex", "&#xE9;&#Xe9;", "éé"},
+		{"supplementary", "&#128512;", "\U0001F600"},
+		{"no semicolon", "&#233 ", "&#233 "},
+		{"at end", "&#233", "&#233"},
+		{"no digits", "&#;&#x;", "&#;&#x;"},
+		{"surrogate", "&#xD800;", "&#xD800;"},
+		{"too large", "&#x110000;", "&#x110000;"},
+		{"overflow", "&#99999999999999;", "&#99999999999999;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Translate(NumericEntityUnescaper{}, tt.s); got != tt.want {
+				t.Errorf("NumericEntityUnescaper = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
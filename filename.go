@@ -0,0 +1,115 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// FilenameOptions configures SanitizeFilename.
+type FilenameOptions struct {
+	// Replacement replaces every illegal character, they are removed if empty.
+	Replacement string
+	// MaxBytes limits the length of the name in bytes, 255 if zero.
+	MaxBytes int
+	// Fallback is returned when nothing usable is left of the name, "untitled" if empty.
+	Fallback string
+}
+
+// windowsReserved holds the device names Windows reserves with any extension.
+var windowsReserved = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// isIllegalFilenameRune reports whether the rune may not be used in a file name on Windows, macOS or Linux.
+func isIllegalFilenameRune(r rune) bool {
+	switch r {
+	case '<', '>', ':', '"', '/', '\\', '|', '?', '*', 0x7F, utf8.RuneError:
+		return true
+	}
+	return r < 0x20
+}
+
+// SanitizeFilename Returns s as a file name that is valid on Windows, macOS and Linux.
+// Illegal and control characters are replaced, leading spaces and trailing dots and spaces
+// are trimmed and the name is cut to the byte limit on a character boundary, keeping the extension.
+// Reserved device names, also those the cut makes, get a "_" appended within the limit. A blank
+// result returns the fallback.
+//  stringutils.SanitizeFilename("", stringutils.FilenameOptions{})                                  = "untitled"
+//  stringutils.SanitizeFilename("   ", stringutils.FilenameOptions{})                               = "untitled"
+//  stringutils.SanitizeFilename("a/b", stringutils.FilenameOptions{})                               = "ab"
+//  stringutils.SanitizeFilename("report:final?.pdf", stringutils.FilenameOptions{Replacement: "_"}) = "report_final_.pdf"
+//  stringutils.SanitizeFilename("CON.txt", stringutils.FilenameOptions{})                           = "CON_.txt"
+//  stringutils.SanitizeFilename("notes. . ", stringutils.FilenameOptions{})                         = "notes"
+//  stringutils.SanitizeFilename("CON", stringutils.FilenameOptions{MaxBytes: 3})                    = "CO_"
+//  stringutils.SanitizeFilename("CONSOLE", stringutils.FilenameOptions{MaxBytes: 3})                = "CO_"
+func SanitizeFilename(s string, opts FilenameOptions) string {
+	fallback := DefaultIfEmpty(opts.Fallback, "untitled")
+	maxBytes := opts.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = 255
+	}
+	replacement := strings.Map(func(r rune) rune {
+		if isIllegalFilenameRune(r) {
+			return -1
+		}
+		return r
+	}, opts.Replacement)
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if isIllegalFilenameRune(r) {
+			b.WriteString(replacement)
+		} else {
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	name := trimFilename(truncateFilename(trimFilename(b.String()), maxBytes))
+	if IsBlank(name) || name == "." || name == ".." {
+		return fallback
+	}
+	base, ext := name, ""
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		base, ext = name[:i], name[i:]
+	}
+	if windowsReserved[strings.ToUpper(strings.TrimRight(base, " "))] {
+		// the base is cut for the "_" to fit, it is still not reserved as no reserved name has a "_"
+		name = truncateUTF8(base, maxBytes-len(ext)-1) + "_" + ext
+	}
+	return name
+}
+
+// trimFilename removes leading spaces and trailing dots and spaces.
+func trimFilename(s string) string {
+	return strings.TrimRight(strings.TrimLeft(s, " "), ". ")
+}
+
+// truncateFilename cuts s to at most n bytes without splitting a character,
+// the extension is kept if it takes at most half of n.
+func truncateFilename(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	ext := ""
+	if i := strings.LastIndexByte(s, '.'); i > 0 && len(s)-i <= n/2 {
+		s, ext = s[:i], s[i:]
+	}
+	return truncateUTF8(s, n-len(ext)) + ext
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,69 @@
+package stringutils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeFilename(t *testing.T) {
+	type args struct {
+		s    string
+		opts FilenameOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", FilenameOptions{}}, "untitled"},
+		{"blank", args{"   ", FilenameOptions{}}, "untitled"},
+		{"fallback", args{"   ", FilenameOptions{Fallback: "file"}}, "file"},
+		{"dot", args{".", FilenameOptions{}}, "untitled"},
+		{"dot dot", args{"..", FilenameOptions{}}, "untitled"},
+		{"slash", args{"a/b", FilenameOptions{}}, "ab"},
+		{"illegal", args{"report:final?.pdf", FilenameOptions{Replacement: "_"}}, "report_final_.pdf"},
+		{"illegal replacement", args{"a/b", FilenameOptions{Replacement: "/-"}}, "a-b"},
+		{"control", args{"a\x00b\tc\x7f", FilenameOptions{}}, "abc"},
+		{"invalid utf8", args{"a\xffb", FilenameOptions{Replacement: "_"}}, "a_b"},
+		{"only illegal", args{"???", FilenameOptions{}}, "untitled"},
+		{"reserved", args{"CON.txt", FilenameOptions{}}, "CON_.txt"},
+		{"reserved lower", args{"lpt1", FilenameOptions{}}, "lpt1_"},
+		{"reserved prefix", args{"CONSOLE.txt", FilenameOptions{}}, "CONSOLE.txt"},
+		{"reserved max bytes", args{"CON", FilenameOptions{MaxBytes: 3}}, "CO_"},
+		{"reserved max bytes ext", args{"aux.txt", FilenameOptions{MaxBytes: 7}}, "au_.txt"},
+		{"reserved max bytes room", args{"aux.txt", FilenameOptions{MaxBytes: 8}}, "aux_.txt"},
+		{"reserved by truncation", args{"CONSOLE", FilenameOptions{MaxBytes: 3}}, "CO_"},
+		{"reserved by truncation ext", args{"LPT12345.log", FilenameOptions{MaxBytes: 8}}, "LPT_.log"},
+		{"trailing dots", args{"notes. . ", FilenameOptions{}}, "notes"},
+		{"leading spaces", args{"  .bashrc", FilenameOptions{}}, ".bashrc"},
+		{"unicode", args{"résumé 履歴書.pdf", FilenameOptions{}}, "résumé 履歴書.pdf"},
+		{"max bytes", args{"abcdef.txt", FilenameOptions{MaxBytes: 8}}, "abcd.txt"},
+		{"max bytes utf8", args{"ééé.txt", FilenameOptions{MaxBytes: 9}}, "éé.txt"},
+		{"max bytes long ext", args{"a.verylongextension", FilenameOptions{MaxBytes: 6}}, "a.very"},
+		{"default max bytes", args{strings.Repeat("a", 300) + ".txt", FilenameOptions{}}, strings.Repeat("a", 251) + ".txt"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeFilename(tt.args.s, tt.args.opts); got != tt.want {
+				t.Errorf("SanitizeFilename() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeFilename_maxBytesReserved(t *testing.T) {
+	for name := range windowsReserved {
+		for _, s := range []string{name, strings.ToLower(name) + ".txt", name + "XYZ", name + " .tar.gz"} {
+			for n := 1; n <= len(s)+2; n++ {
+				got := SanitizeFilename(s, FilenameOptions{MaxBytes: n})
+				base := strings.SplitN(got, ".", 2)[0]
+				if len(got) > n {
+					t.Errorf("SanitizeFilename(%q, MaxBytes %d) = %q, longer than the limit", s, n, got)
+				}
+				if windowsReserved[strings.ToUpper(strings.TrimRight(base, " "))] {
+					t.Errorf("SanitizeFilename(%q, MaxBytes %d) = %q, a reserved name", s, n, got)
+				}
+			}
+		}
+	}
+}
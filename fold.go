@@ -0,0 +1,186 @@
+package stringutils
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// caseFold is one entry of the full case folding table.
+type caseFold struct {
+	r    rune
+	fold string
+}
+
+// foldRune returns the full case folding of r, false when r folds to itself.
+func foldRune(r rune) (string, bool) {
+	i := sort.Search(len(caseFoldTable), func(i int) bool { return caseFoldTable[i].r >= r })
+	if i < len(caseFoldTable) && caseFoldTable[i].r == r {
+		return caseFoldTable[i].fold, true
+	}
+	return "", false
+}
+
+// foldCase returns the full case folding of s.
+func foldCase(s string) string {
+	fs, _ := foldCaseOffsets(s, false)
+	return fs
+}
+
+// foldCaseOffsets returns the full case folding of s. With offsets it also returns, for every byte
+// offset of the folded string and its end, the offset in s it came from, or -1 when the offset
+// is inside the folding of a single rune of s.
+func foldCaseOffsets(s string, offsets bool) (string, []int) {
+	var b strings.Builder
+	b.Grow(len(s))
+	var offs []int
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		f, ok := foldRune(r)
+		if !ok {
+			f = s[i : i+size]
+		}
+		if offsets {
+			offs = append(offs, i)
+			for k := 1; k < len(f); k++ {
+				offs = append(offs, -1)
+			}
+		}
+		b.WriteString(f)
+		i += size
+	}
+	if offsets {
+		offs = append(offs, len(s))
+	}
+	return b.String(), offs
+}
+
+// indexFolded returns the offset in fs of the first occurrence of fsub at or after from, which
+// starts and ends on the folding boundaries of the original string, or -1.
+func indexFolded(fs string, offs []int, fsub string, from int) int {
+	for from <= len(fs) {
+		i := strings.Index(fs[from:], fsub)
+		if i < 0 {
+			return -1
+		}
+		i += from
+		if offs[i] >= 0 && offs[i+len(fsub)] >= 0 {
+			return i
+		}
+		from = i + 1
+	}
+	return -1
+}
+
+// EqualsIgnoreCase Checks if two strings are equal under full Unicode case folding.
+//  stringutils.EqualsIgnoreCase("", "")              = true
+//  stringutils.EqualsIgnoreCase("", "abc")           = false
+//  stringutils.EqualsIgnoreCase("abc", "ABC")        = true
+//  stringutils.EqualsIgnoreCase("Straße", "STRASSE") = true
+//  stringutils.EqualsIgnoreCase("abc", "abd")        = false
+func EqualsIgnoreCase(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return foldCase(a) == foldCase(b)
+}
+
+// IndexIgnoreCase Returns the byte index in s of the first occurrence of substr under full Unicode
+// case folding, or -1 if substr is not present. A match never starts or ends inside a character of s.
+//  stringutils.IndexIgnoreCase("", "")              = 0
+//  stringutils.IndexIgnoreCase("abc", "")           = 0
+//  stringutils.IndexIgnoreCase("aabaabaa", "B")     = 2
+//  stringutils.IndexIgnoreCase("die Straße", "SS")  = 8
+//  stringutils.IndexIgnoreCase("die Straße", "S")   = 4
+//  stringutils.IndexIgnoreCase("abc", "d")          = -1
+func IndexIgnoreCase(s, substr string) int {
+	if IsEmpty(substr) {
+		return 0
+	}
+	fs, offs := foldCaseOffsets(s, true)
+	i := indexFolded(fs, offs, foldCase(substr), 0)
+	if i < 0 {
+		return -1
+	}
+	return offs[i]
+}
+
+// ContainsIgnoreCase Checks if substr is within s under full Unicode case folding.
+//  stringutils.ContainsIgnoreCase("", "")              = true
+//  stringutils.ContainsIgnoreCase("abc", "")           = true
+//  stringutils.ContainsIgnoreCase("", "a")             = false
+//  stringutils.ContainsIgnoreCase("abc", "B")          = true
+//  stringutils.ContainsIgnoreCase("STRASSE", "straße") = true
+//  stringutils.ContainsIgnoreCase("abc", "z")          = false
+func ContainsIgnoreCase(s, substr string) bool {
+	return IndexIgnoreCase(s, substr) >= 0
+}
+
+// HasPrefixIgnoreCase Checks if s begins with prefix under full Unicode case folding.
+//  stringutils.HasPrefixIgnoreCase("", "")             = true
+//  stringutils.HasPrefixIgnoreCase("abc", "")          = true
+//  stringutils.HasPrefixIgnoreCase("ABCDEF", "abc")    = true
+//  stringutils.HasPrefixIgnoreCase("Straße", "STRAS")  = false
+//  stringutils.HasPrefixIgnoreCase("Straße", "STRASS") = true
+func HasPrefixIgnoreCase(s, prefix string) bool {
+	fp := foldCase(prefix)
+	fs, offs := foldCaseOffsets(s, true)
+	return strings.HasPrefix(fs, fp) && offs[len(fp)] >= 0
+}
+
+// ReplaceIgnoreCase Returns a copy of s with the first n non-overlapping occurrences of old,
+// matched under full Unicode case folding, replaced by new. If n < 0, there is no limit
+// on the number of replacements. An empty old leaves s unchanged.
+//  stringutils.ReplaceIgnoreCase("", "", "x", -1)         = ""
+//  stringutils.ReplaceIgnoreCase("abc", "", "x", -1)      = "abc"
+//  stringutils.ReplaceIgnoreCase("aBcAbc", "abc", "x", -1) = "xx"
+//  stringutils.ReplaceIgnoreCase("aBcAbc", "abc", "x", 1)  = "xAbc"
+//  stringutils.ReplaceIgnoreCase("Straße", "SS", "s", -1)  = "Strase"
+func ReplaceIgnoreCase(s, old, new string, n int) string {
+	if IsEmpty(old) || n == 0 {
+		return s
+	}
+	fold := foldCase(old)
+	fs, offs := foldCaseOffsets(s, true)
+	var b strings.Builder
+	last, from := 0, 0
+	for n != 0 {
+		i := indexFolded(fs, offs, fold, from)
+		if i < 0 {
+			break
+		}
+		b.WriteString(s[last:offs[i]])
+		b.WriteString(new)
+		last = offs[i+len(fold)]
+		from = i + len(fold)
+		n--
+	}
+	if b.Len() == 0 && last == 0 {
+		return s
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// CountIgnoreCase Counts the non-overlapping occurrences of substr in s under full Unicode case folding.
+// An empty substr counts as 0.
+//  stringutils.CountIgnoreCase("", "")          = 0
+//  stringutils.CountIgnoreCase("abc", "")       = 0
+//  stringutils.CountIgnoreCase("abba", "A")     = 2
+//  stringutils.CountIgnoreCase("aaaa", "AA")    = 2
+//  stringutils.CountIgnoreCase("ßSS", "ss")     = 2
+func CountIgnoreCase(s, substr string) int {
+	if IsEmpty(substr) {
+		return 0
+	}
+	fold := foldCase(substr)
+	fs, offs := foldCaseOffsets(s, true)
+	count := 0
+	for from := 0; ; count++ {
+		i := indexFolded(fs, offs, fold, from)
+		if i < 0 {
+			return count
+		}
+		from = i + len(fold)
+	}
+}
@@ -0,0 +1,171 @@
+package stringutils
+
+import "testing"
+
+func TestEqualsIgnoreCase(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", ""}, true},
+		{"empty,abc", args{"", "abc"}, false},
+		{"abc,ABC", args{"abc", "ABC"}, true},
+		{"Straße,STRASSE", args{"Straße", "STRASSE"}, true},
+		{"ǰ", args{"ǰ", "J̌"}, true},
+		{"Kelvin", args{"K", "k"}, true},
+		{"sigma", args{"ς", "Σ"}, true},
+		{"abc,abd", args{"abc", "abd"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualsIgnoreCase(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("EqualsIgnoreCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexIgnoreCase(t *testing.T) {
+	type args struct {
+		s      string
+		substr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", ""}, 0},
+		{"abc,empty", args{"abc", ""}, 0},
+		{"empty,a", args{"", "a"}, -1},
+		{"aabaabaa,B", args{"aabaabaa", "B"}, 2},
+		{"die Straße,SS", args{"die Straße", "SS"}, 8},
+		{"die Straße,S", args{"die Straße", "S"}, 4},
+		{"ß half", args{"ß", "s"}, -1},
+		{"ß after half", args{"ßs", "s"}, 2},
+		{"ÄÖÜ,öü", args{"ÄÖÜ", "öü"}, 2},
+		{"invalid utf8", args{"\xffABC", "b"}, 2},
+		{"abc,d", args{"abc", "d"}, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexIgnoreCase(tt.args.s, tt.args.substr); got != tt.want {
+				t.Errorf("IndexIgnoreCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsIgnoreCase(t *testing.T) {
+	type args struct {
+		s      string
+		substr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", ""}, true},
+		{"abc,empty", args{"abc", ""}, true},
+		{"empty,a", args{"", "a"}, false},
+		{"abc,B", args{"abc", "B"}, true},
+		{"STRASSE,straße", args{"STRASSE", "straße"}, true},
+		{"abc,z", args{"abc", "z"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsIgnoreCase(tt.args.s, tt.args.substr); got != tt.want {
+				t.Errorf("ContainsIgnoreCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasPrefixIgnoreCase(t *testing.T) {
+	type args struct {
+		s      string
+		prefix string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", ""}, true},
+		{"abc,empty", args{"abc", ""}, true},
+		{"empty,a", args{"", "a"}, false},
+		{"ABCDEF,abc", args{"ABCDEF", "abc"}, true},
+		{"Straße,STRAS", args{"Straße", "STRAS"}, false},
+		{"Straße,STRASS", args{"Straße", "STRASS"}, true},
+		{"abc,abcd", args{"abc", "abcd"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasPrefixIgnoreCase(tt.args.s, tt.args.prefix); got != tt.want {
+				t.Errorf("HasPrefixIgnoreCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceIgnoreCase(t *testing.T) {
+	type args struct {
+		s   string
+		old string
+		new string
+		n   int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "", "x", -1}, ""},
+		{"abc,empty", args{"abc", "", "x", -1}, "abc"},
+		{"n=0", args{"abc", "a", "x", 0}, "abc"},
+		{"all", args{"aBcAbc", "abc", "x", -1}, "xx"},
+		{"first", args{"aBcAbc", "abc", "x", 1}, "xAbc"},
+		{"ß", args{"Straße", "SS", "s", -1}, "Strase"},
+		{"to empty", args{"aXbxc", "x", "", -1}, "abc"},
+		{"no match", args{"abc", "z", "x", -1}, "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReplaceIgnoreCase(tt.args.s, tt.args.old, tt.args.new, tt.args.n); got != tt.want {
+				t.Errorf("ReplaceIgnoreCase() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCountIgnoreCase(t *testing.T) {
+	type args struct {
+		s      string
+		substr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", ""}, 0},
+		{"abc,empty", args{"abc", ""}, 0},
+		{"abba,A", args{"abba", "A"}, 2},
+		{"aaaa,AA", args{"aaaa", "AA"}, 2},
+		{"ßSS,ss", args{"ßSS", "ss"}, 2},
+		{"abc,z", args{"abc", "z"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountIgnoreCase(tt.args.s, tt.args.substr); got != tt.want {
+				t.Errorf("CountIgnoreCase() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
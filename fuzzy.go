@@ -0,0 +1,206 @@
+package stringutils
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Fuzzy scoring, modelled on fzf: every matched rune scores, gaps cost, matches at word
+// starts, camelCase humps and runs of consecutive matches earn bonuses.
+const (
+	fuzzyScoreMatch        = 16
+	fuzzyGapStart          = -3
+	fuzzyGapExtension      = -1
+	fuzzyBonusBoundary     = fuzzyScoreMatch / 2
+	fuzzyBonusWhite        = fuzzyBonusBoundary + 2
+	fuzzyBonusDelimiter    = fuzzyBonusBoundary + 1
+	fuzzyBonusCamel        = fuzzyBonusBoundary - 1
+	fuzzyBonusConsecutive  = -(fuzzyGapStart + fuzzyGapExtension)
+	fuzzyFirstCharMultiple = 2
+)
+
+// FuzzyResult is a candidate matched by a fuzzy pattern.
+type FuzzyResult struct {
+	// Candidate is the matched string.
+	Candidate string
+	// Score rates the match, higher is better.
+	Score int
+	// Positions are the rune indexes in Candidate of the matched pattern runes.
+	Positions []int
+}
+
+type fuzzyClass int
+
+const (
+	fuzzyWhite fuzzyClass = iota
+	fuzzyDelimiter
+	fuzzyNonWord
+	fuzzyLower
+	fuzzyUpper
+	fuzzyLetter
+	fuzzyDigit
+)
+
+func fuzzyClassOf(r rune) fuzzyClass {
+	switch {
+	case unicode.IsSpace(r) || IsInformationSeparator(r):
+		return fuzzyWhite
+	case r == '/' || r == ',' || r == ':' || r == ';' || r == '|' || r == '-' || r == '_' || r == '.':
+		return fuzzyDelimiter
+	case unicode.IsLower(r):
+		return fuzzyLower
+	case unicode.IsUpper(r) || unicode.IsTitle(r):
+		return fuzzyUpper
+	case unicode.IsLetter(r):
+		return fuzzyLetter
+	case unicode.IsDigit(r):
+		return fuzzyDigit
+	}
+	return fuzzyNonWord
+}
+
+// fuzzyBonus returns the bonus for matching a rune of class cur following a rune of class prev.
+func fuzzyBonus(prev, cur fuzzyClass) int {
+	if cur > fuzzyNonWord {
+		switch prev {
+		case fuzzyWhite:
+			return fuzzyBonusWhite
+		case fuzzyDelimiter:
+			return fuzzyBonusDelimiter
+		case fuzzyNonWord:
+			return fuzzyBonusBoundary
+		}
+	}
+	if (prev == fuzzyLower && cur == fuzzyUpper) || (prev != fuzzyDigit && cur == fuzzyDigit) {
+		return fuzzyBonusCamel
+	}
+	return 0
+}
+
+// FuzzyMatch Checks if the runes of pattern appear in candidate in order and scores the best
+// such alignment, returning the rune indexes of the matched runes in candidate. The match is
+// case-insensitive unless pattern contains an upper case letter. An empty pattern matches
+// everything with score 0.
+//  stringutils.FuzzyMatch("", "abc")        = 0, [], true
+//  stringutils.FuzzyMatch("abc", "")        = 0, [], false
+//  stringutils.FuzzyMatch("fb", "foo bar")  = 57, [0 4], true
+//  stringutils.FuzzyMatch("fb", "FooBar")   = 55, [0 3], true
+//  stringutils.FuzzyMatch("FB", "foo bar")  = 0, [], false
+//  stringutils.FuzzyMatch("oba", "foobar")  = 56, [2 3 4], true
+func FuzzyMatch(pattern, candidate string) (int, []int, bool) {
+	p, c := []rune(pattern), []rune(candidate)
+	if len(p) == 0 {
+		return 0, nil, true
+	}
+	if len(p) > len(c) {
+		return 0, nil, false
+	}
+	caseSensitive := false
+	for _, r := range p {
+		if unicode.IsUpper(r) {
+			caseSensitive = true
+			break
+		}
+	}
+	equal := func(a, b rune) bool {
+		return a == b || (!caseSensitive && a == unicode.ToLower(b))
+	}
+	for i, j := 0, 0; i < len(p); j++ {
+		if j == len(c) {
+			return 0, nil, false
+		}
+		if equal(p[i], c[j]) {
+			i++
+		}
+	}
+
+	bonus := make([]int, len(c))
+	prev := fuzzyWhite
+	for j, r := range c {
+		cur := fuzzyClassOf(r)
+		bonus[j] = fuzzyBonus(prev, cur)
+		prev = cur
+	}
+
+	// score[i][j] is the best score with p[i] matched at c[j], from[i][j] the position of p[i-1].
+	const none = -1 << 30
+	score := make([][]int, len(p))
+	from := make([][]int, len(p))
+	for i := range p {
+		score[i] = make([]int, len(c))
+		from[i] = make([]int, len(c))
+		// gap is the best score[i-1][k] for k < j-1 less the extension of the gap from k to j,
+		// carried forward so that each match does not rescan the row, gapFrom is its k.
+		gap, gapFrom := none, -1
+		for j := range c {
+			score[i][j] = none
+			from[i][j] = -1
+			if i > 0 && j >= 2 {
+				if gap > none {
+					gap += fuzzyGapExtension
+				}
+				// on a tie the nearer match wins
+				if s := score[i-1][j-2]; s > none && s >= gap {
+					gap, gapFrom = s, j-2
+				}
+			}
+			if !equal(p[i], c[j]) {
+				continue
+			}
+			if i == 0 {
+				score[i][j] = fuzzyScoreMatch + bonus[j]*fuzzyFirstCharMultiple
+				continue
+			}
+			if j == 0 {
+				continue
+			}
+			if s := score[i-1][j-1]; s > none {
+				score[i][j] = s + fuzzyScoreMatch + maxInt(bonus[j], fuzzyBonusConsecutive)
+				from[i][j] = j - 1
+			}
+			if gap > none {
+				if s := gap + fuzzyScoreMatch + bonus[j] + fuzzyGapStart; s > score[i][j] {
+					score[i][j] = s
+					from[i][j] = gapFrom
+				}
+			}
+		}
+	}
+
+	last := len(p) - 1
+	best := -1
+	for j := range c {
+		if score[last][j] > none && (best < 0 || score[last][j] > score[last][best]) {
+			best = j
+		}
+	}
+	positions := make([]int, len(p))
+	for i, j := last, best; i >= 0; i-- {
+		positions[i] = j
+		j = from[i][j]
+	}
+	return score[last][best], positions, true
+}
+
+// RankCandidates Returns the candidates matching pattern, see FuzzyMatch, best first. Equal scores
+// rank the shorter candidate first, then keep the given order. A limit > 0 returns at most limit results.
+//  stringutils.RankCandidates("gs", []string{"go", "git status", "gist"}, 0) = ["git status", "gist"]
+//  stringutils.RankCandidates("x", []string{"go", "git"}, 0)                = []
+func RankCandidates(pattern string, candidates []string, limit int) []FuzzyResult {
+	var results []FuzzyResult
+	for _, c := range candidates {
+		if score, positions, ok := FuzzyMatch(pattern, c); ok {
+			results = append(results, FuzzyResult{Candidate: c, Score: score, Positions: positions})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return len(results[i].Candidate) < len(results[j].Candidate)
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
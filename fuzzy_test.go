@@ -0,0 +1,85 @@
+package stringutils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	type args struct {
+		pattern   string
+		candidate string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          int
+		wantPositions []int
+		wantOk        bool
+	}{
+		{"empty pattern", args{"", "abc"}, 0, nil, true},
+		{"empty candidate", args{"abc", ""}, 0, nil, false},
+		{"fb,foo bar", args{"fb", "foo bar"}, 57, []int{0, 4}, true},
+		{"fb,FooBar", args{"fb", "FooBar"}, 55, []int{0, 3}, true},
+		{"FB,foo bar", args{"FB", "foo bar"}, 0, nil, false},
+		{"FB,FooBar", args{"FB", "FooBar"}, 55, []int{0, 3}, true},
+		{"oba,foobar", args{"oba", "foobar"}, 56, []int{2, 3, 4}, true},
+		{"out of order", args{"ba", "ab"}, 0, nil, false},
+		{"word start preferred", args{"b", "abc b"}, 36, []int{4}, true},
+		{"delimiter", args{"mf", "src/main_file.go"}, 53, []int{4, 9}, true},
+		{"runes", args{"日語", "日本語"}, 49, []int{0, 2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotPositions, gotOk := FuzzyMatch(tt.args.pattern, tt.args.candidate)
+			if got != tt.want {
+				t.Errorf("FuzzyMatch() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotPositions, tt.wantPositions) {
+				t.Errorf("FuzzyMatch() gotPositions = %v, want %v", gotPositions, tt.wantPositions)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("FuzzyMatch() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRankCandidates(t *testing.T) {
+	type args struct {
+		pattern    string
+		candidates []string
+		limit      int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{"no candidates", args{"gs", nil, 0}, nil},
+		{"no match", args{"x", []string{"go", "git"}, 0}, nil},
+		{"ranked", args{"gs", []string{"go", "gist", "git status"}, 0}, []string{"git status", "gist"}},
+		{"limit", args{"gs", []string{"go", "gist", "git status"}, 1}, []string{"git status"}},
+		{"shorter first", args{"ab", []string{"abcd", "abc", "ab"}, 0}, []string{"ab", "abc", "abcd"}},
+		{"empty pattern", args{"", []string{"b", "a"}, 0}, []string{"b", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range RankCandidates(tt.args.pattern, tt.args.candidates, tt.args.limit) {
+				got = append(got, r.Candidate)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RankCandidates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkFuzzyMatch_longCandidate(b *testing.B) {
+	candidate := strings.Repeat("src/internal/pkg/module_", 125) + "main.go"
+	for i := 0; i < b.N; i++ {
+		FuzzyMatch("smgo", candidate)
+	}
+}
@@ -0,0 +1,77 @@
+package stringutils
+
+import "unicode/utf8"
+
+// graphemeLen returns the length in bytes of the extended grapheme cluster at the start of s,
+// following the boundary rules of UAX #29.
+func graphemeLen(s string) int {
+	if s == "" {
+		return 0
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	prev := graphemeClassOf(r)
+	// regional is the number of regional indicators ending at prev, pict whether the runes
+	// ending at prev are an extended pictographic followed by extends
+	regional, pict := 0, prev == graphemeExtPict
+	if prev == graphemeRegionalIndicator {
+		regional = 1
+	}
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+		cur := graphemeClassOf(r)
+		if graphemeBreak(prev, cur, regional%2 == 1, pict) {
+			break
+		}
+		if cur == graphemeRegionalIndicator {
+			regional++
+		} else {
+			regional = 0
+		}
+		switch cur {
+		case graphemeExtPict:
+			pict = true
+		case graphemeExtend, graphemeZWJ:
+			// a joiner ends the extends of an emoji sequence
+			pict = pict && prev != graphemeZWJ
+		default:
+			pict = false
+		}
+		prev = cur
+		n += size
+	}
+	return n
+}
+
+// graphemeBreak reports whether there is a grapheme cluster boundary between runes of the classes
+// prev and cur. oddRegional tells if an odd number of regional indicators ends at prev, pict if
+// an extended pictographic followed by extends and a joiner ends at prev.
+func graphemeBreak(prev, cur graphemeClass, oddRegional, pict bool) bool {
+	switch {
+	case prev == graphemeCR && cur == graphemeLF:
+		return false
+	case prev == graphemeCR || prev == graphemeLF || prev == graphemeControl,
+		cur == graphemeCR || cur == graphemeLF || cur == graphemeControl:
+		return true
+	case prev == graphemeL && (cur == graphemeL || cur == graphemeV || cur == graphemeLV || cur == graphemeLVT),
+		(prev == graphemeLV || prev == graphemeV) && (cur == graphemeV || cur == graphemeT),
+		(prev == graphemeLVT || prev == graphemeT) && cur == graphemeT:
+		return false
+	case cur == graphemeExtend || cur == graphemeZWJ || cur == graphemeSpacingMark, prev == graphemePrepend:
+		return false
+	case prev == graphemeZWJ && cur == graphemeExtPict:
+		return !pict
+	case prev == graphemeRegionalIndicator && cur == graphemeRegionalIndicator:
+		return !oddRegional
+	}
+	return true
+}
+
+// graphemeBoundaries returns the byte offsets of the grapheme cluster boundaries of s, including 0 and len(s).
+func graphemeBoundaries(s string) []int {
+	boundaries := []int{0}
+	for i := 0; i < len(s); {
+		i += graphemeLen(s[i:])
+		boundaries = append(boundaries, i)
+	}
+	return boundaries
+}
@@ -0,0 +1,35 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_graphemeBoundaries(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []int
+	}{
+		{"empty", "", []int{0}},
+		{"ascii", "ab", []int{0, 1, 2}},
+		{"combining mark", "e\u0301x", []int{0, 3, 4}},
+		{"crlf", "\r\nx", []int{0, 2, 3}},
+		{"control", "\r\u0301", []int{0, 1, 3}},
+		{"flags", "\U0001F1FA\U0001F1F8\U0001F1EB\U0001F1F7\U0001F1E9", []int{0, 8, 16, 20}},
+		{"emoji zwj sequence", "\U0001F468\u200d\U0001F469\u200d\U0001F467x", []int{0, 18, 19}},
+		{"zwj without emoji", "a\u200d\U0001F44D", []int{0, 4, 8}},
+		{"double zwj", "\U0001F44D\u200d\u200d\U0001F44D", []int{0, 10, 14}},
+		{"hangul jamo", "\u1100\u1161\u11a8x", []int{0, 9, 10}},
+		{"hangul syllable and jamo", "가\u11a8", []int{0, 6}},
+		{"spacing mark", "\u0915\u094d\u0937\u093f", []int{0, 6, 12}},
+		{"prepend", "\u0600a", []int{0, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := graphemeBoundaries(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("graphemeBoundaries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
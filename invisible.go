@@ -0,0 +1,146 @@
+package stringutils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// InvisibleCategory is the kind of an invisible rune, the categories are bit flags which may be combined.
+type InvisibleCategory uint16
+
+const (
+	// InvisibleBidi are the bidirectional embedding, override and isolate controls and marks,
+	// which can reorder the displayed text as in the "Trojan Source" attack.
+	InvisibleBidi InvisibleCategory = 1 << iota
+	// InvisibleZeroWidth are the zero width space, joiners and no-break space.
+	InvisibleZeroWidth
+	// InvisibleControl are the C0 and C1 control characters but tab, line feed and carriage return.
+	InvisibleControl
+	// InvisibleSpace are the spaces but U+0020 and the line and paragraph separators.
+	InvisibleSpace
+	// InvisibleFormat are the other format characters which are not displayed, e.g. the soft hyphen.
+	InvisibleFormat
+	// InvisibleFiller are the Hangul fillers, letters which are displayed as blanks.
+	InvisibleFiller
+	// InvisibleTag are the tag characters, which can hide ASCII text.
+	InvisibleTag
+	// InvisibleVariationSelector are the variation selectors.
+	InvisibleVariationSelector
+)
+
+// String returns the name of a single category, e.g. "bidi".
+func (c InvisibleCategory) String() string {
+	switch c {
+	case InvisibleBidi:
+		return "bidi"
+	case InvisibleZeroWidth:
+		return "zero-width"
+	case InvisibleControl:
+		return "control"
+	case InvisibleSpace:
+		return "space"
+	case InvisibleFormat:
+		return "format"
+	case InvisibleFiller:
+		return "filler"
+	case InvisibleTag:
+		return "tag"
+	case InvisibleVariationSelector:
+		return "variation-selector"
+	}
+	return "InvisibleCategory(" + strconv.Itoa(int(c)) + ")"
+}
+
+// invisibleEntry is an entry of invisibleTable.
+type invisibleEntry struct {
+	r        rune
+	category InvisibleCategory
+	name     string
+}
+
+// lookupInvisible returns the entry of invisibleTable for the rune.
+func lookupInvisible(r rune) (invisibleEntry, bool) {
+	i := sort.Search(len(invisibleTable), func(i int) bool { return invisibleTable[i].r >= r })
+	if i < len(invisibleTable) && invisibleTable[i].r == r {
+		return invisibleTable[i], true
+	}
+	return invisibleEntry{}, false
+}
+
+// InvisibleRune is a suspicious rune found by ScanInvisible.
+type InvisibleRune struct {
+	// Index is the byte index of the rune in the string.
+	Index int
+	Rune  rune
+	// Category is the kind of the rune.
+	Category InvisibleCategory
+	// Name is the Unicode name of the rune, e.g. "RIGHT-TO-LEFT OVERRIDE".
+	Name string
+}
+
+// ScanInvisible Returns the runes of s which are invisible or change how the text around them is
+// displayed, so that the text may not read as it is: bidi controls, zero width characters,
+// control characters but tab and line breaks, spaces but U+0020, fillers, tags, variation
+// selectors and other format characters. Nil if there are none.
+//  stringutils.ScanInvisible("")               = []
+//  stringutils.ScanInvisible("abc")            = []
+//  stringutils.ScanInvisible("a\u202Eb")       = [{1 U+202E bidi "RIGHT-TO-LEFT OVERRIDE"}]
+//  stringutils.ScanInvisible("is\u200BAdmin")  = [{2 U+200B zero-width "ZERO WIDTH SPACE"}]
+func ScanInvisible(s string) []InvisibleRune {
+	var found []InvisibleRune
+	for i, r := range s {
+		if r >= 0x20 && r < 0x7F {
+			continue
+		}
+		if e, ok := lookupInvisible(r); ok {
+			found = append(found, InvisibleRune{Index: i, Rune: r, Category: e.category, Name: e.name})
+		}
+	}
+	return found
+}
+
+// InvisibleOptions configures SanitizeInvisible.
+type InvisibleOptions struct {
+	// Escape replaces the runes with Go escapes such as `\u202E` instead of removing them.
+	Escape bool
+	// Keep holds the categories which are left alone, e.g. InvisibleZeroWidth|InvisibleVariationSelector
+	// to keep emoji sequences intact.
+	Keep InvisibleCategory
+}
+
+// SanitizeInvisible Returns s with the runes ScanInvisible reports removed or escaped.
+//  stringutils.SanitizeInvisible("", stringutils.InvisibleOptions{})                         = ""
+//  stringutils.SanitizeInvisible("a\u202Eb\u200B", stringutils.InvisibleOptions{})           = "ab"
+//  stringutils.SanitizeInvisible("a\u202Eb", stringutils.InvisibleOptions{Escape: true})     = "a\\u202Eb"
+//  stringutils.SanitizeInvisible("a\u00A0b\u202E", stringutils.InvisibleOptions{Keep: stringutils.InvisibleSpace}) = "a\u00A0b"
+func SanitizeInvisible(s string, opts InvisibleOptions) string {
+	found := ScanInvisible(s)
+	if len(found) == 0 {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	last := 0
+	for _, f := range found {
+		if f.Category&opts.Keep != 0 {
+			continue
+		}
+		b.WriteString(s[last:f.Index])
+		if opts.Escape {
+			b.WriteString(escapeRune(f.Rune))
+		}
+		last = f.Index + len(string(f.Rune))
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// escapeRune returns the Go escape of the rune, "\uXXXX" or "\UXXXXXXXX".
+func escapeRune(r rune) string {
+	h := strings.ToUpper(strconv.FormatInt(int64(r), 16))
+	if r > 0xFFFF {
+		return `\U` + strings.Repeat("0", 8-len(h)) + h
+	}
+	return `\u` + strings.Repeat("0", 4-len(h)) + h
+}
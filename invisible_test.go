@@ -0,0 +1,104 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScanInvisible(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want []InvisibleRune
+	}{
+		{"empty", "", nil},
+		{"plain", "abc", nil},
+		{"allowed whitespace", "a\tb\nc\r\n d", nil},
+		{"visible non-ascii", "café 😀 日本", nil},
+		{"bidi override", "a\u202Eb", []InvisibleRune{{1, 0x202E, InvisibleBidi, "RIGHT-TO-LEFT OVERRIDE"}}},
+		{"trojan source", "/*\u202E } \u2066if (isAdmin)\u2069 \u2066 begin admins only */", []InvisibleRune{
+			{2, 0x202E, InvisibleBidi, "RIGHT-TO-LEFT OVERRIDE"},
+			{8, 0x2066, InvisibleBidi, "LEFT-TO-RIGHT ISOLATE"},
+			{23, 0x2069, InvisibleBidi, "POP DIRECTIONAL ISOLATE"},
+			{27, 0x2066, InvisibleBidi, "LEFT-TO-RIGHT ISOLATE"},
+		}},
+		{"zero width", "is\u200BAdmin\u200D\uFEFF", []InvisibleRune{
+			{2, 0x200B, InvisibleZeroWidth, "ZERO WIDTH SPACE"},
+			{10, 0x200D, InvisibleZeroWidth, "ZERO WIDTH JOINER"},
+			{13, 0xFEFF, InvisibleZeroWidth, "ZERO WIDTH NO-BREAK SPACE"},
+		}},
+		{"controls", "\x00\x1c\x7f\u0085", []InvisibleRune{
+			{0, 0x00, InvisibleControl, "NULL"},
+			{1, 0x1C, InvisibleControl, "INFORMATION SEPARATOR FOUR"},
+			{2, 0x7F, InvisibleControl, "DELETE"},
+			{3, 0x85, InvisibleControl, "NEXT LINE"},
+		}},
+		{"spaces", "a\u00A0b\u3000\u2028", []InvisibleRune{
+			{1, 0xA0, InvisibleSpace, "NO-BREAK SPACE"},
+			{4, 0x3000, InvisibleSpace, "IDEOGRAPHIC SPACE"},
+			{7, 0x2028, InvisibleSpace, "LINE SEPARATOR"},
+		}},
+		{"format", "soft\u00ADhyphen", []InvisibleRune{{4, 0xAD, InvisibleFormat, "SOFT HYPHEN"}}},
+		{"filler", "var \u3164 = 1", []InvisibleRune{{4, 0x3164, InvisibleFiller, "HANGUL FILLER"}}},
+		{"tags", "a\U000E0041\U000E007F", []InvisibleRune{
+			{1, 0xE0041, InvisibleTag, "TAG LATIN CAPITAL LETTER A"},
+			{5, 0xE007F, InvisibleTag, "CANCEL TAG"},
+		}},
+		{"variation selectors", "❤\uFE0F\U000E0100", []InvisibleRune{
+			{3, 0xFE0F, InvisibleVariationSelector, "VARIATION SELECTOR-16"},
+			{6, 0xE0100, InvisibleVariationSelector, "VARIATION SELECTOR-17"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScanInvisible(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanInvisible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSanitizeInvisible(t *testing.T) {
+	type args struct {
+		s    string
+		opts InvisibleOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", InvisibleOptions{}}, ""},
+		{"plain", args{"a\tb\n", InvisibleOptions{}}, "a\tb\n"},
+		{"remove", args{"a\u202Eb\u200B\x00c", InvisibleOptions{}}, "abc"},
+		{"escape", args{"a\u202Eb\x00", InvisibleOptions{Escape: true}}, `a\u202Eb\u0000`},
+		{"escape supplementary", args{"a\U000E0041", InvisibleOptions{Escape: true}}, `a\U000E0041`},
+		{"keep", args{"a\u00A0b\u202E", InvisibleOptions{Keep: InvisibleSpace}}, "a\u00A0b"},
+		{"keep emoji", args{"\U0001F468\u200D\U0001F469❤\uFE0F\u202E", InvisibleOptions{Keep: InvisibleZeroWidth | InvisibleVariationSelector}}, "\U0001F468\u200D\U0001F469❤\uFE0F"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeInvisible(tt.args.s, tt.args.opts); got != tt.want {
+				t.Errorf("SanitizeInvisible() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvisibleCategory_String(t *testing.T) {
+	tests := []struct {
+		c    InvisibleCategory
+		want string
+	}{
+		{InvisibleBidi, "bidi"},
+		{InvisibleVariationSelector, "variation-selector"},
+		{InvisibleBidi | InvisibleTag, "InvisibleCategory(65)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.c.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package stringutils
+
+// suffixState is a state of a suffix automaton, the class of substrings sharing their end positions.
+// first is the rune index where the first occurrence of these substrings ends.
+type suffixState struct {
+	length int
+	link   int
+	first  int
+	next   map[rune]int
+}
+
+// suffixAutomaton is the minimal automaton accepting the suffixes of a rune sequence, built in linear time.
+type suffixAutomaton struct {
+	states []suffixState
+	last   int
+}
+
+func newSuffixAutomaton(rs []rune) *suffixAutomaton {
+	sa := &suffixAutomaton{states: make([]suffixState, 1, 2*len(rs)+1)}
+	sa.states[0] = suffixState{link: -1, first: -1, next: make(map[rune]int)}
+	for i, r := range rs {
+		sa.extend(r, i)
+	}
+	return sa
+}
+
+// extend appends the rune r at index pos to the automaton.
+func (sa *suffixAutomaton) extend(r rune, pos int) {
+	cur := len(sa.states)
+	sa.states = append(sa.states, suffixState{length: sa.states[sa.last].length + 1, first: pos, next: make(map[rune]int)})
+	p := sa.last
+	for p != -1 {
+		if _, ok := sa.states[p].next[r]; ok {
+			break
+		}
+		sa.states[p].next[r] = cur
+		p = sa.states[p].link
+	}
+	switch {
+	case p == -1:
+		sa.states[cur].link = 0
+	case sa.states[sa.states[p].next[r]].length == sa.states[p].length+1:
+		sa.states[cur].link = sa.states[p].next[r]
+	default:
+		q := sa.states[p].next[r]
+		clone := len(sa.states)
+		next := make(map[rune]int, len(sa.states[q].next))
+		for k, v := range sa.states[q].next {
+			next[k] = v
+		}
+		sa.states = append(sa.states, suffixState{
+			length: sa.states[p].length + 1,
+			link:   sa.states[q].link,
+			first:  sa.states[q].first,
+			next:   next,
+		})
+		for p != -1 && sa.states[p].next[r] == q {
+			sa.states[p].next[r] = clone
+			p = sa.states[p].link
+		}
+		sa.states[q].link = clone
+		sa.states[cur].link = clone
+	}
+	sa.last = cur
+}
+
+// matchLengths returns for every state the length of the longest of its substrings occurring in rs.
+func (sa *suffixAutomaton) matchLengths(rs []rune) []int {
+	match := make([]int, len(sa.states))
+	v, l := 0, 0
+	for _, r := range rs {
+		for v != 0 {
+			if _, ok := sa.states[v].next[r]; ok {
+				break
+			}
+			v = sa.states[v].link
+			l = sa.states[v].length
+		}
+		if next, ok := sa.states[v].next[r]; ok {
+			v = next
+			l++
+		}
+		match[v] = maxInt(match[v], l)
+	}
+	// a substring occurring in rs implies its suffixes do, longer states come first
+	order := make([][]int, sa.states[sa.last].length+1)
+	for i, st := range sa.states {
+		order[st.length] = append(order[st.length], i)
+	}
+	for length := len(order) - 1; length > 0; length-- {
+		for _, i := range order[length] {
+			if link := sa.states[i].link; match[i] > 0 && link > 0 {
+				match[link] = sa.states[link].length
+			}
+		}
+	}
+	return match
+}
+
+// longestCommonSubstring returns the longest substring of all the strings, the first in ss[0] when
+// there are several.
+func longestCommonSubstring(ss []string) string {
+	first := []rune(ss[0])
+	sa := newSuffixAutomaton(first)
+	best := make([]int, len(sa.states))
+	for i, st := range sa.states {
+		best[i] = st.length
+	}
+	for _, s := range ss[1:] {
+		for i, m := range sa.matchLengths([]rune(s)) {
+			best[i] = minInt(best[i], m)
+		}
+	}
+	length, end := 0, 0
+	for i, st := range sa.states {
+		if best[i] > length || (best[i] == length && length > 0 && st.first < end) {
+			length, end = best[i], st.first
+		}
+	}
+	if length == 0 {
+		return ""
+	}
+	return string(first[end-length+1 : end+1])
+}
+
+// LongestCommonSubstring Returns the longest string which is a substring of both a and b, the first
+// one in a when there are several. Runs in time linear in the length of the strings.
+//  stringutils.LongestCommonSubstring("", "abc")                = ""
+//  stringutils.LongestCommonSubstring("abc", "xyz")             = ""
+//  stringutils.LongestCommonSubstring("xabcy", "zabcw")         = "abc"
+//  stringutils.LongestCommonSubstring("ab-cd", "cd+ab")         = "ab"
+//  stringutils.LongestCommonSubstring("user 42 logged in", "user 7 logged out") = " logged "
+func LongestCommonSubstring(a, b string) string {
+	return longestCommonSubstring([]string{a, b})
+}
+
+// LongestCommonSubstringAll Returns the longest string which is a substring of all the strings,
+// the first one in the first string when there are several.
+//  stringutils.LongestCommonSubstringAll()                          = "", error
+//  stringutils.LongestCommonSubstringAll("abc")                     = "abc"
+//  stringutils.LongestCommonSubstringAll("", "abc")                 = ""
+//  stringutils.LongestCommonSubstringAll("xabcy", "zabcw", "abd")   = "ab"
+func LongestCommonSubstringAll(ss ...string) (string, error) {
+	if len(ss) == 0 {
+		return "", ErrNoArguments
+	}
+	return longestCommonSubstring(ss), nil
+}
+
+// LongestCommonSubsequence Returns a longest string whose runes appear in order in both a and b,
+// with the rune indexes of its runes in a and in b. It uses the shortest edit script of Diff, so
+// memory use is linear in the length of the strings.
+//  stringutils.LongestCommonSubsequence("", "abc")       = "", [], []
+//  stringutils.LongestCommonSubsequence("abc", "xyz")    = "", [], []
+//  stringutils.LongestCommonSubsequence("abcde", "ace")  = "ace", [0 2 4], [0 1 2]
+//  stringutils.LongestCommonSubsequence("xaybz", "ab")   = "ab", [1 3], [0 1]
+func LongestCommonSubsequence(a, b string) (string, []int, []int) {
+	var subsequence []rune
+	var indexesA, indexesB []int
+	i, j := 0, 0
+	for _, e := range diffTokens(splitRunes(a), splitRunes(b), false) {
+		for _, r := range e.Text {
+			switch e.Op {
+			case DiffEqual:
+				subsequence = append(subsequence, r)
+				indexesA = append(indexesA, i)
+				indexesB = append(indexesB, j)
+				i++
+				j++
+			case DiffDelete:
+				i++
+			case DiffInsert:
+				j++
+			}
+		}
+	}
+	return string(subsequence), indexesA, indexesB
+}
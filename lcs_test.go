@@ -0,0 +1,155 @@
+package stringutils
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLongestCommonSubstring(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty,empty", args{"", ""}, ""},
+		{"empty,abc", args{"", "abc"}, ""},
+		{"abc,empty", args{"abc", ""}, ""},
+		{"abc,xyz", args{"abc", "xyz"}, ""},
+		{"abc,abc", args{"abc", "abc"}, "abc"},
+		{"xabcy,zabcw", args{"xabcy", "zabcw"}, "abc"},
+		{"first in a", args{"ab-cd", "cd+ab"}, "ab"},
+		{"repeated", args{"aaaa", "aa"}, "aa"},
+		{"log lines", args{"user 42 logged in", "user 7 logged out"}, " logged "},
+		{"runes", args{"日本語のテキスト", "中国語のテキスト"}, "語のテキスト"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestCommonSubstring(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("LongestCommonSubstring() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestCommonSubstringAll(t *testing.T) {
+	type args struct {
+		ss []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"[]", args{[]string{}}, "", true},
+		{"[abc]", args{[]string{"abc"}}, "abc", false},
+		{"[empty,abc]", args{[]string{"", "abc"}}, "", false},
+		{"[abc,empty]", args{[]string{"abc", ""}}, "", false},
+		{"[xabcy,zabcw,abd]", args{[]string{"xabcy", "zabcw", "abd"}}, "ab", false},
+		{"[xabcy,zabcw,bcd]", args{[]string{"xabcy", "zabcw", "bcd"}}, "bc", false},
+		{"[abc,bca,cab]", args{[]string{"abc", "bca", "cab"}}, "a", false},
+		{"templates", args{[]string{"GET /users/1 200", "GET /users/22 404", "GET /users/333 500"}}, "GET /users/", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LongestCommonSubstringAll(tt.args.ss...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LongestCommonSubstringAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("LongestCommonSubstringAll() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestCommonSubsequence(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     string
+		indexesA []int
+		indexesB []int
+	}{
+		{"empty,empty", args{"", ""}, "", nil, nil},
+		{"empty,abc", args{"", "abc"}, "", nil, nil},
+		{"abc,xyz", args{"abc", "xyz"}, "", nil, nil},
+		{"abc,abc", args{"abc", "abc"}, "abc", []int{0, 1, 2}, []int{0, 1, 2}},
+		{"abcde,ace", args{"abcde", "ace"}, "ace", []int{0, 2, 4}, []int{0, 1, 2}},
+		{"xaybz,ab", args{"xaybz", "ab"}, "ab", []int{1, 3}, []int{0, 1}},
+		{"runes", args{"日本語", "日語"}, "日語", []int{0, 2}, []int{0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, indexesA, indexesB := LongestCommonSubsequence(tt.args.a, tt.args.b)
+			if got != tt.want {
+				t.Errorf("LongestCommonSubsequence() got = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(indexesA, tt.indexesA) {
+				t.Errorf("LongestCommonSubsequence() indexesA = %v, want %v", indexesA, tt.indexesA)
+			}
+			if !reflect.DeepEqual(indexesB, tt.indexesB) {
+				t.Errorf("LongestCommonSubsequence() indexesB = %v, want %v", indexesB, tt.indexesB)
+			}
+		})
+	}
+}
+
+func TestLongestCommonRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() string {
+		b := make([]byte, r.Intn(20))
+		for i := range b {
+			b[i] = "abc"[r.Intn(3)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := random(), random()
+		// dynamic programming reference for the lengths
+		substring, subsequence := 0, 0
+		sub := make([][]int, len(a)+1)
+		seq := make([][]int, len(a)+1)
+		for x := range sub {
+			sub[x] = make([]int, len(b)+1)
+			seq[x] = make([]int, len(b)+1)
+		}
+		for x := 1; x <= len(a); x++ {
+			for y := 1; y <= len(b); y++ {
+				if a[x-1] == b[y-1] {
+					sub[x][y] = sub[x-1][y-1] + 1
+					seq[x][y] = seq[x-1][y-1] + 1
+				} else {
+					seq[x][y] = maxInt(seq[x-1][y], seq[x][y-1])
+				}
+				substring = maxInt(substring, sub[x][y])
+			}
+		}
+		subsequence = seq[len(a)][len(b)]
+
+		got := LongestCommonSubstring(a, b)
+		if len(got) != substring || !strings.Contains(a, got) || !strings.Contains(b, got) {
+			t.Fatalf("LongestCommonSubstring(%q, %q) = %q, want length %d", a, b, got, substring)
+		}
+		got, indexesA, indexesB := LongestCommonSubsequence(a, b)
+		if len(got) != subsequence {
+			t.Fatalf("LongestCommonSubsequence(%q, %q) = %q, want length %d", a, b, got, subsequence)
+		}
+		for k := range got {
+			if a[indexesA[k]] != got[k] || b[indexesB[k]] != got[k] {
+				t.Fatalf("LongestCommonSubsequence(%q, %q) indexes %v %v do not match %q", a, b, indexesA, indexesB, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package stringutils
+
+// levenshteinInf stands for a distance above the threshold, it is small enough to add 1 to.
+const levenshteinInf = int(^uint(0) >> 2)
+
+// LevenshteinDistance Returns the number of single rune insertions, deletions and substitutions
+// needed to change a into b. Memory use is linear in the length of the shorter string.
+//  stringutils.LevenshteinDistance("", "")               = 0
+//  stringutils.LevenshteinDistance("", "a")              = 1
+//  stringutils.LevenshteinDistance("aaapppp", "")        = 7
+//  stringutils.LevenshteinDistance("frog", "fog")        = 1
+//  stringutils.LevenshteinDistance("fly", "ant")         = 3
+//  stringutils.LevenshteinDistance("elephant", "hippo")  = 7
+//  stringutils.LevenshteinDistance("hello", "hallo")     = 1
+func LevenshteinDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	if len(s) < len(t) {
+		s, t = t, s
+	}
+	row := make([]int, len(t)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			up := row[j]
+			row[j] = minInt(minInt(up+1, row[j-1]+1), diag+cost)
+			diag = up
+		}
+	}
+	return row[len(t)]
+}
+
+// LevenshteinDistanceWithin Returns the Levenshtein distance of a and b if it is less than or equal
+// to k, otherwise -1. Only a diagonal band of width 2k+1 is computed and the computation stops as
+// soon as the distance exceeds k. A negative k always returns -1.
+//  stringutils.LevenshteinDistanceWithin("", "", 0)              = 0
+//  stringutils.LevenshteinDistanceWithin("aaapppp", "", 8)       = 7
+//  stringutils.LevenshteinDistanceWithin("aaapppp", "", 7)       = 7
+//  stringutils.LevenshteinDistanceWithin("aaapppp", "", 6)       = -1
+//  stringutils.LevenshteinDistanceWithin("elephant", "hippo", 7) = 7
+//  stringutils.LevenshteinDistanceWithin("elephant", "hippo", 6) = -1
+func LevenshteinDistanceWithin(a, b string, k int) int {
+	if k < 0 {
+		return -1
+	}
+	s, t := []rune(a), []rune(b)
+	if len(s) < len(t) {
+		s, t = t, s
+	}
+	m, n := len(s), len(t)
+	if m-n > k {
+		return -1
+	}
+	if n == 0 {
+		return m
+	}
+	// the distance is at most m, a larger k is no bound and would overflow the band
+	k = minInt(k, m)
+	prev, cur := make([]int, n+1), make([]int, n+1)
+	for j := range prev {
+		prev[j] = levenshteinInf
+		if j <= k {
+			prev[j] = j
+		}
+	}
+	for i := 1; i <= m; i++ {
+		lo, hi := maxInt(1, i-k), minInt(n, i+k)
+		rowMin := levenshteinInf
+		if lo > 1 {
+			cur[lo-1] = levenshteinInf
+		} else {
+			cur[0] = i
+			rowMin = i
+		}
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(minInt(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+			rowMin = minInt(rowMin, cur[j])
+		}
+		if hi < n {
+			cur[hi+1] = levenshteinInf
+		}
+		if rowMin > k {
+			return -1
+		}
+		prev, cur = cur, prev
+	}
+	if prev[n] > k {
+		return -1
+	}
+	return prev[n]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
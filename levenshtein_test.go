@@ -0,0 +1,83 @@
+package stringutils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", ""}, 0},
+		{"empty,a", args{"", "a"}, 1},
+		{"aaapppp,empty", args{"aaapppp", ""}, 7},
+		{"frog,fog", args{"frog", "fog"}, 1},
+		{"fly,ant", args{"fly", "ant"}, 3},
+		{"elephant,hippo", args{"elephant", "hippo"}, 7},
+		{"hippo,elephant", args{"hippo", "elephant"}, 7},
+		{"hippo,zzzzzzzz", args{"hippo", "zzzzzzzz"}, 8},
+		{"hello,hallo", args{"hello", "hallo"}, 1},
+		{"kitten,sitting", args{"kitten", "sitting"}, 3},
+		{"runes", args{"Straße", "Strasse"}, 2},
+		{"equal", args{"日本語", "日本語"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LevenshteinDistance(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("LevenshteinDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistanceWithin(t *testing.T) {
+	type args struct {
+		a string
+		b string
+		k int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{"empty", args{"", "", 0}, 0},
+		{"negative k", args{"", "", -1}, -1},
+		{"aaapppp,empty,8", args{"aaapppp", "", 8}, 7},
+		{"aaapppp,empty,7", args{"aaapppp", "", 7}, 7},
+		{"aaapppp,empty,6", args{"aaapppp", "", 6}, -1},
+		{"b,a,0", args{"b", "a", 0}, -1},
+		{"a,b,0", args{"a", "b", 0}, -1},
+		{"aa,aa,0", args{"aa", "aa", 0}, 0},
+		{"aa,aa,2", args{"aa", "aa", 2}, 0},
+		{"aaa,bbb,2", args{"aaa", "bbb", 2}, -1},
+		{"aaa,bbb,3", args{"aaa", "bbb", 3}, 3},
+		{"aaaaaa,b,10", args{"aaaaaa", "b", 10}, 6},
+		{"aaapppp,b,8", args{"aaapppp", "b", 8}, 7},
+		{"a,bbb,4", args{"a", "bbb", 4}, 3},
+		{"elephant,hippo,7", args{"elephant", "hippo", 7}, 7},
+		{"elephant,hippo,6", args{"elephant", "hippo", 6}, -1},
+		{"hippo,elephant,7", args{"hippo", "elephant", 7}, 7},
+		{"hippo,elephant,6", args{"hippo", "elephant", 6}, -1},
+		{"hippo,zzzzzzzz,8", args{"hippo", "zzzzzzzz", 8}, 8},
+		{"hippo,zzzzzzzz,1", args{"hippo", "zzzzzzzz", 1}, -1},
+		{"hello,hallo,1", args{"hello", "hallo", 1}, 1},
+		{"hello,hallo,MaxInt", args{"hello", "hallo", math.MaxInt}, 1},
+		{"elephant,hippo,MaxInt", args{"elephant", "hippo", math.MaxInt}, 7},
+		{"empty,abc,MaxInt", args{"", "abc", math.MaxInt}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LevenshteinDistanceWithin(tt.args.a, tt.args.b, tt.args.k); got != tt.want {
+				t.Errorf("LevenshteinDistanceWithin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
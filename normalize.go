@@ -0,0 +1,352 @@
+package stringutils
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// NormalizationForm is one of the Unicode normalization forms of UAX #15.
+type NormalizationForm int
+
+const (
+	// NFC is the canonical decomposition followed by canonical composition.
+	NFC NormalizationForm = iota
+	// NFD is the canonical decomposition.
+	NFD
+	// NFKC is the compatibility decomposition followed by canonical composition.
+	NFKC
+	// NFKD is the compatibility decomposition.
+	NFKD
+)
+
+// String returns the name of the form, e.g. "NFC".
+func (f NormalizationForm) String() string {
+	switch f {
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	}
+	return "NormalizationForm(" + strconv.Itoa(int(f)) + ")"
+}
+
+func (f NormalizationForm) valid() bool {
+	return f >= NFC && f <= NFKD
+}
+
+func (f NormalizationForm) compat() bool {
+	return f == NFKC || f == NFKD
+}
+
+func (f NormalizationForm) composed() bool {
+	return f == NFC || f == NFKC
+}
+
+// decomposition is one entry of the decomposition table, composable marks the canonical pairs
+// that are primary composites, i.e. not excluded from composition.
+type decomposition struct {
+	r          rune
+	compat     bool
+	composable bool
+	mapping    string
+}
+
+// Hangul syllable constants of Unicode chapter 3.12.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+var (
+	compositionOnce  sync.Once
+	compositionPairs map[[2]rune]rune
+	composesBackward map[rune]bool
+)
+
+// loadComposition builds the canonical composition pairs from the decomposition table.
+func loadComposition() {
+	compositionPairs = make(map[[2]rune]rune)
+	composesBackward = make(map[rune]bool)
+	for _, d := range decompositionTable {
+		if !d.composable {
+			continue
+		}
+		rs := []rune(d.mapping)
+		compositionPairs[[2]rune{rs[0], rs[1]}] = d.r
+		composesBackward[rs[1]] = true
+	}
+}
+
+// lookupDecomposition returns the decomposition mapping of r, false if r has none.
+func lookupDecomposition(r rune) (decomposition, bool) {
+	i := sort.Search(len(decompositionTable), func(i int) bool { return decompositionTable[i].r >= r })
+	if i < len(decompositionTable) && decompositionTable[i].r == r {
+		return decompositionTable[i], true
+	}
+	return decomposition{}, false
+}
+
+// appendDecomposed appends the full canonical or compatibility decomposition of r to rs.
+func appendDecomposed(rs []rune, r rune, compat bool) []rune {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		rs = append(rs, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			rs = append(rs, hangulTBase+t)
+		}
+		return rs
+	}
+	d, ok := lookupDecomposition(r)
+	if !ok || (d.compat && !compat) {
+		return append(rs, r)
+	}
+	for _, m := range d.mapping {
+		rs = appendDecomposed(rs, m, compat)
+	}
+	return rs
+}
+
+// composePair returns the primary composite of a and b, false if there is none.
+func composePair(a, b rune) (rune, bool) {
+	if l := a - hangulLBase; l >= 0 && l < hangulLCount {
+		if v := b - hangulVBase; v >= 0 && v < hangulVCount {
+			return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+		}
+		return 0, false
+	}
+	if s := a - hangulSBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 {
+		if t := b - hangulTBase; t > 0 && t < hangulTCount {
+			return a + t, true
+		}
+		return 0, false
+	}
+	c, ok := compositionPairs[[2]rune{a, b}]
+	return c, ok
+}
+
+// isComposingBackward reports whether r may compose with a preceding starter.
+func isComposingBackward(r rune) bool {
+	compositionOnce.Do(loadComposition)
+	if r >= hangulVBase && r < hangulVBase+hangulVCount {
+		return true
+	}
+	if r > hangulTBase && r < hangulTBase+hangulTCount {
+		return true
+	}
+	return composesBackward[r]
+}
+
+// normalizer normalizes text incrementally, it keeps the decomposed runes since the last
+// boundary at which no later input can interact with the text before it.
+type normalizer struct {
+	form    NormalizationForm
+	pending []rune
+	scratch []rune
+	out     []byte
+}
+
+// write normalizes the complete runes of p into out and returns the number of bytes consumed,
+// an incomplete rune at the end of p is left unconsumed unless final is set.
+func (n *normalizer) write(p []byte, final bool) int {
+	i := 0
+	for i < len(p) {
+		if !final && !utf8.FullRune(p[i:]) {
+			break
+		}
+		r, size := utf8.DecodeRune(p[i:])
+		if r == utf8.RuneError && size == 1 {
+			n.flush()
+			n.out = append(n.out, p[i])
+			i++
+			continue
+		}
+		n.writeRune(r)
+		i += size
+	}
+	return i
+}
+
+// writeString is write for strings, it always consumes all of s.
+func (n *normalizer) writeString(s string) {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			n.flush()
+			n.out = append(n.out, s[i])
+			i++
+			continue
+		}
+		n.writeRune(r)
+		i += size
+	}
+}
+
+func (n *normalizer) writeRune(r rune) {
+	n.scratch = appendDecomposed(n.scratch[:0], r, n.form.compat())
+	first := n.scratch[0]
+	if combiningClass(first) == 0 && (!n.form.composed() || !isComposingBackward(first)) {
+		n.flush()
+	}
+	n.pending = append(n.pending, n.scratch...)
+}
+
+// flush writes the pending runes in canonical order, composed for NFC and NFKC.
+func (n *normalizer) flush() {
+	if len(n.pending) == 0 {
+		return
+	}
+	rs := n.pending
+	reorderCanonical(rs)
+	if n.form.composed() {
+		rs = composeCanonical(rs)
+	}
+	var buf [utf8.UTFMax]byte
+	for _, r := range rs {
+		k := utf8.EncodeRune(buf[:], r)
+		n.out = append(n.out, buf[:k]...)
+	}
+	n.pending = n.pending[:0]
+}
+
+// reorderCanonical sorts every run of non-starters by combining class, keeping the order of equal classes.
+func reorderCanonical(rs []rune) {
+	for i := 0; i < len(rs); {
+		if combiningClass(rs[i]) == 0 {
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(rs) && combiningClass(rs[j]) != 0 {
+			j++
+		}
+		if j-i > 1 {
+			run := rs[i:j]
+			sort.SliceStable(run, func(a, b int) bool { return combiningClass(run[a]) < combiningClass(run[b]) })
+		}
+		i = j
+	}
+}
+
+// composeCanonical applies the canonical composition algorithm to decomposed, reordered runes in place.
+func composeCanonical(rs []rune) []rune {
+	compositionOnce.Do(loadComposition)
+	if len(rs) == 0 {
+		return rs
+	}
+	starter := -1
+	if combiningClass(rs[0]) == 0 {
+		starter = 0
+	}
+	out := rs[:1]
+	lastClass := combiningClass(rs[0])
+	for _, r := range rs[1:] {
+		class := combiningClass(r)
+		if starter >= 0 && (lastClass < class || (lastClass == 0 && len(out)-1 == starter)) {
+			if c, ok := composePair(out[starter], r); ok {
+				out[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter = len(out)
+		}
+		lastClass = class
+		out = append(out, r)
+	}
+	return out
+}
+
+// Normalize Returns s in the normalization form f. Invalid UTF-8 bytes are copied unchanged,
+// an unknown form returns s unchanged.
+//  stringutils.Normalize("", stringutils.NFC)             = ""
+//  stringutils.Normalize("abc", stringutils.NFD)          = "abc"
+//  stringutils.Normalize("e\u0301", stringutils.NFC)     = "\u00e9"
+//  stringutils.Normalize("\u00e9", stringutils.NFD)      = "e\u0301"
+//  stringutils.Normalize("\ufb01", stringutils.NFC)      = "\ufb01"
+//  stringutils.Normalize("\ufb01", stringutils.NFKC)     = "fi"
+func Normalize(s string, f NormalizationForm) string {
+	if !f.valid() || isASCII(s) {
+		return s
+	}
+	n := normalizer{form: f, out: make([]byte, 0, len(s))}
+	n.writeString(s)
+	n.flush()
+	return string(n.out)
+}
+
+// IsNormalized Checks if s is already in the normalization form f.
+//  stringutils.IsNormalized("", stringutils.NFC)         = true
+//  stringutils.IsNormalized("abc", stringutils.NFD)      = true
+//  stringutils.IsNormalized("e\u0301", stringutils.NFC) = false
+//  stringutils.IsNormalized("e\u0301", stringutils.NFD) = true
+func IsNormalized(s string, f NormalizationForm) bool {
+	return Normalize(s, f) == s
+}
+
+// normalizeReader normalizes the text read from r.
+type normalizeReader struct {
+	r   io.Reader
+	n   normalizer
+	in  []byte
+	err error
+}
+
+// NewNormalizeReader Returns a reader which reads from r and normalizes the text into the form f.
+// Normalized text is produced at boundaries where no later input can change it, so memory stays
+// bounded for any text except very long runs of combining characters.
+func NewNormalizeReader(r io.Reader, f NormalizationForm) io.Reader {
+	return &normalizeReader{r: r, n: normalizer{form: f}}
+}
+
+// Read implements io.Reader.
+func (nr *normalizeReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(nr.n.out) == 0 {
+		if nr.err != nil {
+			return 0, nr.err
+		}
+		if !nr.n.form.valid() {
+			return nr.r.Read(p)
+		}
+		buf := make([]byte, 4096)
+		k, err := nr.r.Read(buf)
+		nr.in = append(nr.in, buf[:k]...)
+		if err != nil {
+			nr.err = err
+			nr.n.write(nr.in, true)
+			nr.n.flush()
+			nr.in = nil
+			continue
+		}
+		used := nr.n.write(nr.in, false)
+		nr.in = append(nr.in[:0], nr.in[used:]...)
+	}
+	k := copy(p, nr.n.out)
+	nr.n.out = nr.n.out[:copy(nr.n.out, nr.n.out[k:])]
+	return k, nil
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
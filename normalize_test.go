@@ -0,0 +1,170 @@
+package stringutils
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNormalize(t *testing.T) {
+	type args struct {
+		s string
+		f NormalizationForm
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NFC}, ""},
+		{"abc", args{"abc", NFD}, "abc"},
+		{"NFC e+acute", args{"e\u0301", NFC}, "\u00e9"},
+		{"NFD é", args{"\u00e9", NFD}, "e\u0301"},
+		{"NFC ﬁ", args{"\ufb01", NFC}, "\ufb01"},
+		{"NFKC ﬁ", args{"\ufb01", NFKC}, "fi"},
+		{"NFD reorder", args{"a\u0301\u0323", NFD}, "a\u0323\u0301"},
+		{"NFC reorder", args{"d\u0307\u0323", NFC}, "\u1e0d\u0307"},
+		{"NFC hangul", args{"\u1100\u1161\u11a8", NFC}, "\uac01"},
+		{"NFD hangul", args{"\uac01", NFD}, "\u1100\u1161\u11a8"},
+		{"NFC singleton", args{"\u2126", NFC}, "\u03a9"},
+		{"NFC exclusion", args{"\u0958", NFC}, "\u0915\u093c"},
+		{"invalid utf8", args{"e\xff\u0301", NFC}, "e\xff\u0301"},
+		{"unknown form", args{"e\u0301", NormalizationForm(9)}, "e\u0301"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.args.s, tt.args.f); got != tt.want {
+				t.Errorf("Normalize() = %+q, want %+q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNormalized(t *testing.T) {
+	type args struct {
+		s string
+		f NormalizationForm
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", NFC}, true},
+		{"abc", args{"abc", NFD}, true},
+		{"NFC e+acute", args{"e\u0301", NFC}, false},
+		{"NFD e+acute", args{"e\u0301", NFD}, true},
+		{"NFC é", args{"\u00e9", NFC}, true},
+		{"NFKD ﬁ", args{"\ufb01", NFKD}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNormalized(tt.args.s, tt.args.f); got != tt.want {
+				t.Errorf("IsNormalized() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewNormalizeReader(t *testing.T) {
+	type args struct {
+		s string
+		f NormalizationForm
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", NFC}, ""},
+		{"NFC", args{"Ame\u0301lie\u0301 cafe\u0301", NFC}, "Am\u00e9li\u00e9 caf\u00e9"},
+		{"NFD", args{"Am\u00e9lie", NFD}, "Ame\u0301lie"},
+		{"NFKC", args{"\ufb01 \u2460", NFKC}, "fi 1"},
+		{"hangul", args{"\u1100\u1161\u11a8\u1100", NFC}, "\uac01\u1100"},
+		{"long", args{strings.Repeat("e\u0301", 5000), NFC}, strings.Repeat("\u00e9", 5000)},
+		{"invalid utf8", args{"a\xffb", NFD}, "a\xffb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ioutil.ReadAll(NewNormalizeReader(iotest.OneByteReader(strings.NewReader(tt.args.s)), tt.args.f))
+			if err != nil {
+				t.Errorf("NewNormalizeReader() error = %v", err)
+				return
+			}
+			if got := string(b); got != tt.want {
+				t.Errorf("NewNormalizeReader() = %+q, want %+q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizationConformance(t *testing.T) {
+	f, err := os.Open("testdata/NormalizationTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	part1 := make(map[rune]bool)
+	part := ""
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if IsBlank(text) {
+			continue
+		}
+		if strings.HasPrefix(text, "@") {
+			part = strings.TrimSpace(text)
+			continue
+		}
+		fields := strings.Split(text, ";")
+		var c [6]string
+		for i := 1; i <= 5; i++ {
+			c[i] = string(parseCodePoints(fields[i-1]))
+		}
+		if part == "@Part1" {
+			part1[[]rune(c[1])[0]] = true
+		}
+		checks := []struct {
+			f    NormalizationForm
+			want string
+			in   []int
+		}{
+			{NFC, c[2], []int{1, 2, 3}},
+			{NFC, c[4], []int{4, 5}},
+			{NFD, c[3], []int{1, 2, 3}},
+			{NFD, c[5], []int{4, 5}},
+			{NFKC, c[4], []int{1, 2, 3, 4, 5}},
+			{NFKD, c[5], []int{1, 2, 3, 4, 5}},
+		}
+		for _, check := range checks {
+			for _, i := range check.in {
+				if got := Normalize(c[i], check.f); got != check.want {
+					t.Errorf("line %d: %v(c%d) = %+q, want %+q", line, check.f, i, got, check.want)
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if testing.Short() {
+		return
+	}
+	for r := rune(0); r <= 0x10FFFF; r++ {
+		if part1[r] || (r >= 0xD800 && r <= 0xDFFF) {
+			continue
+		}
+		s := string(r)
+		for f := NFC; f <= NFKD; f++ {
+			if got := Normalize(s, f); got != s {
+				t.Errorf("%v(%U) = %+q, want unchanged", f, r, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package stringutils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Patch tuning, as in diff-match-patch: the context kept around every hunk, the longest text
+// matched at once, and the score up to which a fuzzy match is accepted, where every error in
+// the matched text and every patchMatchDistance runes away from the expected location costs 1.
+const (
+	patchMargin          = 4
+	patchMaxMatch        = 32
+	patchMatchThreshold  = 0.5
+	patchMatchDistance   = 1000
+	patchDeleteThreshold = 0.5
+)
+
+// Patch is one hunk of the change from one string to another, the edits include the unchanged
+// context around it. Offsets and lengths count runes, Start1 and Length1 locate the hunk in the
+// text before the patch, Start2 and Length2 in the text after it. Each patch expects the ones
+// before it to be applied.
+type Patch struct {
+	Edits   []DiffEdit
+	Start1  int
+	Start2  int
+	Length1 int
+	Length2 int
+}
+
+// String returns the header of the patch in the unified diff format, e.g. "@@ -1,8 +1,7 @@".
+func (p Patch) String() string {
+	return "@@ -" + unifiedRange(p.Start1, p.Length1) + " +" + unifiedRange(p.Start2, p.Length2) + " @@"
+}
+
+// MakePatch Returns the patches turning a into b, one for every group of changes of the Diff of
+// a and b, each with enough context to be found again in a text which was edited meanwhile.
+// Equal texts return no patches.
+//  stringutils.MakePatch("abc", "abc")        = []
+//  stringutils.MakePatch("abcdef", "abXdef") = [{[{Equal "ab"} {Delete "c"} {Insert "X"} {Equal "def"}] 0 0 6 6}]
+func MakePatch(a, b string) []Patch {
+	edits := Diff(a, b)
+	var patches []Patch
+	var p Patch
+	prepatch := []rune(a)
+	postpatch := append([]rune(nil), prepatch...)
+	count1, count2 := 0, 0
+	for i, e := range edits {
+		n := utf8.RuneCountInString(e.Text)
+		if len(p.Edits) == 0 && e.Op != DiffEqual {
+			p.Start1, p.Start2 = count1, count2
+		}
+		switch e.Op {
+		case DiffInsert:
+			p.Edits = append(p.Edits, e)
+			p.Length2 += n
+			postpatch = append(postpatch[:count2], append([]rune(e.Text), postpatch[count2:]...)...)
+		case DiffDelete:
+			p.Edits = append(p.Edits, e)
+			p.Length1 += n
+			postpatch = append(postpatch[:count2], postpatch[count2+n:]...)
+		case DiffEqual:
+			if n <= 2*patchMargin && len(p.Edits) > 0 && i != len(edits)-1 {
+				p.Edits = append(p.Edits, e)
+				p.Length1 += n
+				p.Length2 += n
+			} else if n >= 2*patchMargin && len(p.Edits) > 0 {
+				patchAddContext(&p, prepatch)
+				patches = append(patches, p)
+				p = Patch{}
+				// later patches are located in the text with this one applied
+				prepatch = append([]rune(nil), postpatch...)
+				count1 = count2
+			}
+		}
+		if e.Op != DiffInsert {
+			count1 += n
+		}
+		if e.Op != DiffDelete {
+			count2 += n
+		}
+	}
+	if len(p.Edits) > 0 {
+		patchAddContext(&p, prepatch)
+		patches = append(patches, p)
+	}
+	return patches
+}
+
+// patchAddContext surrounds the patch with equal text from text, growing it until the text the
+// patch applies to is unique in text or as long as can be matched at once.
+func patchAddContext(p *Patch, text []rune) {
+	s := string(text)
+	pattern := string(text[p.Start2 : p.Start2+p.Length1])
+	padding := 0
+	for strings.Index(s, pattern) != strings.LastIndex(s, pattern) &&
+		utf8.RuneCountInString(pattern) < patchMaxMatch-2*patchMargin {
+		padding += patchMargin
+		pattern = string(text[maxInt(0, p.Start2-padding):minInt(len(text), p.Start2+p.Length1+padding)])
+	}
+	padding += patchMargin
+
+	prefix := text[maxInt(0, p.Start2-padding):p.Start2]
+	suffix := text[p.Start2+p.Length1 : minInt(len(text), p.Start2+p.Length1+padding)]
+	if len(prefix) > 0 {
+		p.Edits = append([]DiffEdit{{DiffEqual, string(prefix)}}, p.Edits...)
+	}
+	if len(suffix) > 0 {
+		p.Edits = append(p.Edits, DiffEdit{DiffEqual, string(suffix)})
+	}
+	p.Start1 -= len(prefix)
+	p.Start2 -= len(prefix)
+	p.Length1 += len(prefix) + len(suffix)
+	p.Length2 += len(prefix) + len(suffix)
+}
+
+// ApplyPatch Applies the patches in order to text and reports for every patch whether it was
+// applied. A patch is applied where its context and deleted text are found in text, exactly or
+// approximately, near the location it was made for, so text may differ from the one the patches
+// were made from. Patches which cannot be found are skipped.
+//  stringutils.ApplyPatch(stringutils.MakePatch("The cat sat.", "The dog sat."), "The cat sat down.") = "The dog sat down.", [true]
+//  stringutils.ApplyPatch(stringutils.MakePatch("The cat sat.", "The dog sat."), "Nothing alike")     = "Nothing alike", [false]
+func ApplyPatch(patches []Patch, text string) (string, []bool) {
+	if len(patches) == 0 {
+		return text, nil
+	}
+	rs := []rune(text)
+	applied := make([]bool, len(patches))
+	delta := 0
+	for i, p := range patches {
+		expected := p.Start2 + delta
+		source := []rune(DiffSource(p.Edits))
+		start, end := -1, -1
+		if len(source) > patchMaxMatch {
+			// long texts are located by their first and last runes
+			start = patchMatch(rs, source[:patchMaxMatch], expected)
+			if start != -1 {
+				end = patchMatch(rs, source[len(source)-patchMaxMatch:], expected+len(source)-patchMaxMatch)
+				if end == -1 || start >= end {
+					start = -1
+				}
+			}
+		} else {
+			start = patchMatch(rs, source, expected)
+		}
+		if start == -1 {
+			delta -= p.Length2 - p.Length1
+			continue
+		}
+		delta = start - expected
+		var found []rune
+		if end == -1 {
+			found = rs[start:minInt(start+len(source), len(rs))]
+		} else {
+			found = rs[start:minInt(end+patchMaxMatch, len(rs))]
+		}
+		if string(source) == string(found) {
+			rs = append(rs[:start:start], append([]rune(DiffTarget(p.Edits)), rs[start+len(source):]...)...)
+			applied[i] = true
+			continue
+		}
+		// the text differs from the one the patch was made from, map the edits onto it
+		edits := Diff(string(source), string(found))
+		if len(source) > patchMaxMatch && float64(diffDistance(edits))/float64(len(source)) > patchDeleteThreshold {
+			continue
+		}
+		index1 := 0
+		for _, e := range p.Edits {
+			n := utf8.RuneCountInString(e.Text)
+			if e.Op != DiffEqual {
+				// the indices may fall past the found text when it is shorter than the source
+				at := minInt(start+diffIndex(edits, index1), len(rs))
+				switch e.Op {
+				case DiffInsert:
+					rs = append(rs[:at:at], append([]rune(e.Text), rs[at:]...)...)
+				case DiffDelete:
+					to := maxInt(at, minInt(start+diffIndex(edits, index1+n), len(rs)))
+					rs = append(rs[:at], rs[to:]...)
+				}
+			}
+			if e.Op != DiffDelete {
+				index1 += n
+			}
+		}
+		applied[i] = true
+	}
+	return string(rs), applied
+}
+
+// patchMatch returns the rune index in text where pattern is found, exactly or with the best
+// score of errors and distance from loc up to patchMatchThreshold, -1 if there is none.
+func patchMatch(text, pattern []rune, loc int) int {
+	loc = maxInt(0, minInt(loc, len(text)))
+	m := len(pattern)
+	if loc+m <= len(text) && string(text[loc:loc+m]) == string(pattern) {
+		return loc
+	}
+	if m == 0 {
+		return loc
+	}
+	p := string(pattern)
+	best, bestScore := -1, patchMatchThreshold
+	span := int(patchMatchThreshold * patchMatchDistance)
+	for start := maxInt(0, loc-span); start <= minInt(len(text), loc+span); start++ {
+		proximity := float64(absInt(start-loc)) / patchMatchDistance
+		if proximity > bestScore {
+			continue
+		}
+		errors := int((bestScore - proximity) * float64(m))
+		d := LevenshteinDistanceWithin(string(text[start:minInt(start+m, len(text))]), p, errors)
+		if d < 0 {
+			continue
+		}
+		if score := float64(d)/float64(m) + proximity; best == -1 || score < bestScore {
+			best, bestScore = start, score
+		}
+	}
+	return best
+}
+
+// diffIndex maps the rune index loc in the source of the edits to the index in their target.
+// An index inside a deletion maps to the start of the deletion.
+func diffIndex(edits []DiffEdit, loc int) int {
+	count1, count2, last1, last2 := 0, 0, 0, 0
+	for _, e := range edits {
+		n := utf8.RuneCountInString(e.Text)
+		if e.Op != DiffInsert {
+			count1 += n
+		}
+		if e.Op != DiffDelete {
+			count2 += n
+		}
+		if count1 > loc {
+			if e.Op == DiffDelete {
+				return last2
+			}
+			break
+		}
+		last1, last2 = count1, count2
+	}
+	return last2 + loc - last1
+}
+
+// diffDistance returns the number of runes inserted, deleted or substituted by the edits.
+func diffDistance(edits []DiffEdit) int {
+	distance, insertions, deletions := 0, 0, 0
+	for _, e := range edits {
+		switch e.Op {
+		case DiffInsert:
+			insertions += utf8.RuneCountInString(e.Text)
+		case DiffDelete:
+			deletions += utf8.RuneCountInString(e.Text)
+		case DiffEqual:
+			distance += maxInt(insertions, deletions)
+			insertions, deletions = 0, 0
+		}
+	}
+	return distance + maxInt(insertions, deletions)
+}
+
+func absInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,106 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMakePatch(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []Patch
+	}{
+		{"empty", args{"", ""}, nil},
+		{"equal", args{"abc", "abc"}, nil},
+		{"insert into empty", args{"", "abc"}, []Patch{{[]DiffEdit{{DiffInsert, "abc"}}, 0, 0, 0, 3}}},
+		{"one change", args{"abcdef", "abXdef"}, []Patch{
+			{[]DiffEdit{{DiffEqual, "ab"}, {DiffDelete, "c"}, {DiffInsert, "X"}, {DiffEqual, "def"}}, 0, 0, 6, 6}}},
+		{"two hunks", args{"The quick brown fox jumps over the lazy dog.", "That quick brown fox jumped over a lazy dog."}, []Patch{
+			{[]DiffEdit{{DiffEqual, "Th"}, {DiffDelete, "e"}, {DiffInsert, "at"}, {DiffEqual, " quick b"}}, 0, 0, 11, 12},
+			{[]DiffEdit{{DiffEqual, "jump"}, {DiffDelete, "s"}, {DiffInsert, "ed"}, {DiffEqual, " over "},
+				{DiffDelete, "the"}, {DiffInsert, "a"}, {DiffEqual, " laz"}}, 21, 21, 18, 17}}},
+		{"runes", args{"日本語のテキスト", "日本人のテキスト"}, []Patch{
+			{[]DiffEdit{{DiffEqual, "日本"}, {DiffDelete, "語"}, {DiffInsert, "人"}, {DiffEqual, "のテキス"}}, 0, 0, 7, 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakePatch(tt.args.a, tt.args.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MakePatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatch_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch Patch
+		want  string
+	}{
+		{"change", Patch{Start1: 0, Start2: 0, Length1: 11, Length2: 12}, "@@ -1,11 +1,12 @@"},
+		{"insert", Patch{Start1: 0, Start2: 0, Length1: 0, Length2: 3}, "@@ -0,0 +1,3 @@"},
+		{"offset", Patch{Start1: 21, Start2: 22, Length1: 18, Length2: 17}, "@@ -22,18 +23,17 @@"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.patch.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	const (
+		fox1 = "The quick brown fox jumps over the lazy dog."
+		fox2 = "That quick brown fox jumped over a lazy dog."
+	)
+	long1 := "x1234567890123456789012345678901234567890123456789012345678901234567890y"
+	long2 := "xabcy"
+	type args struct {
+		a    string
+		b    string
+		text string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		applied []bool
+	}{
+		{"no patches", args{"abc", "abc", "xyz"}, "xyz", nil},
+		{"exact", args{fox1, fox2, fox1}, fox2, []bool{true, true}},
+		{"edited text", args{fox1, fox2, "The quick red rabbit jumps over the tired tiger."},
+			"That quick red rabbit jumped over a tired tiger.", []bool{true, true}},
+		{"unrelated text", args{fox1, fox2, "I am the very model of a modern major general."},
+			"I am the very model of a modern major general.", []bool{false, false}},
+		{"moved text", args{"The cat sat.", "The dog sat.", "Some words first. The cat sat."},
+			"Some words first. The dog sat.", []bool{true}},
+		{"insert into empty", args{"", "abc", ""}, "abc", []bool{true}},
+		{"truncated text", args{fox1, fox2, "The quick brown fox jumps"}, "That quick brown fox jumps", []bool{true, false}},
+		{"shorter text", args{"aooknfbdlbjdk", "aoo\nclbjd\ne", "aeofibj dlbjdk"}, "a\nclbjdk\ne", []bool{true}},
+		{"heavily edited text", args{fox1, fox2, "Th quik brwn fx jumps ovr th lzy dg."},
+			"That quik brwn fx jumped ovr a lzy dg.", []bool{true, true}},
+		{"big delete exact", args{long1, long2, long1}, long2, []bool{true}},
+		{"big delete edited", args{long1, long2, "x12345678901234567890---------++++++++++---------12345678901234567890y"},
+			"xabcy", []bool{true}},
+		{"big delete too different", args{long1, long2, "x12345678901234567890---------++++++++++--------+++++++++---------+++++++++--------01234567890y"},
+			"x12345678901234567890---------++++++++++--------+++++++++---------+++++++++--------01234567890y", []bool{false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, applied := ApplyPatch(MakePatch(tt.args.a, tt.args.b), tt.args.text)
+			if got != tt.want {
+				t.Errorf("ApplyPatch() got = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(applied, tt.applied) {
+				t.Errorf("ApplyPatch() applied = %v, want %v", applied, tt.applied)
+			}
+		})
+	}
+}
@@ -0,0 +1,570 @@
+package phonetic
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DoubleMetaphone is Lawrence Philips' Double Metaphone, which improves on Metaphone for names of
+// non-English origin and computes an alternate key for spellings with two common pronunciations.
+// Encode returns the primary key.
+type DoubleMetaphone struct {
+	// MaxLength is the length the keys are truncated to, 0 means 4 and a negative value no limit.
+	MaxLength int
+}
+
+// Encode Returns the primary Double Metaphone key of s, see Encodings.
+//  phonetic.DoubleMetaphone{}.Encode("")         = ""
+//  phonetic.DoubleMetaphone{}.Encode("Thompson") = "TMPS"
+//  phonetic.DoubleMetaphone{}.Encode("Smith")    = "SM0"
+//  phonetic.DoubleMetaphone{}.Encode("Schmidt")  = "XMT"
+func (m DoubleMetaphone) Encode(s string) string {
+	primary, _ := m.Encodings(s)
+	return primary
+}
+
+// Encodings Returns the primary and the alternate Double Metaphone keys of s. The alternate key
+// equals the primary one when the spelling has a single likely pronunciation.
+//  phonetic.DoubleMetaphone{}.Encodings("")        = "", ""
+//  phonetic.DoubleMetaphone{}.Encodings("Smith")   = "SM0", "XMT"
+//  phonetic.DoubleMetaphone{}.Encodings("Schmidt") = "XMT", "SMT"
+//  phonetic.DoubleMetaphone{}.Encodings("Jose")    = "HS", "HS"
+func (m DoubleMetaphone) Encodings(s string) (string, string) {
+	// spaces are kept, some rules look at the first word, Ç and Ñ have rules of their own
+	s = strings.Join(strings.Fields(letters(s, func(r rune) bool { return unicode.IsSpace(r) || r == 'Ç' || r == 'Ñ' })), " ")
+	if s == "" {
+		return "", ""
+	}
+	w := doubleMetaphoneWord{
+		s:             []rune(s),
+		slavoGermanic: strings.ContainsAny(s, "WK") || strings.Contains(s, "CZ") || strings.Contains(s, "WITZ"),
+	}
+	k := &doubleMetaphoneKeys{limit: maxLength(m.MaxLength, 4)}
+	i := 0
+	for _, silent := range []string{"GN", "KN", "PN", "WR", "PS"} {
+		if strings.HasPrefix(s, silent) {
+			i = 1
+			break
+		}
+	}
+	for !k.complete() && i < len(w.s) {
+		i = w.encode(k, i)
+	}
+	return k.primary.String(), k.alternate.String()
+}
+
+// doubleMetaphoneKeys collects the primary and the alternate key up to their length limit.
+type doubleMetaphoneKeys struct {
+	primary, alternate strings.Builder
+	limit              int
+}
+
+func (k *doubleMetaphoneKeys) complete() bool {
+	return k.primary.Len() >= k.limit && k.alternate.Len() >= k.limit
+}
+
+func (k *doubleMetaphoneKeys) appendPrimary(s string) {
+	if n := k.limit - k.primary.Len(); len(s) > n {
+		s = s[:n]
+	}
+	k.primary.WriteString(s)
+}
+
+func (k *doubleMetaphoneKeys) appendAlternate(s string) {
+	if n := k.limit - k.alternate.Len(); len(s) > n {
+		s = s[:n]
+	}
+	k.alternate.WriteString(s)
+}
+
+// add appends s to both keys.
+func (k *doubleMetaphoneKeys) add(s string) {
+	k.appendPrimary(s)
+	k.appendAlternate(s)
+}
+
+// add2 appends primary and alternate to the respective keys.
+func (k *doubleMetaphoneKeys) add2(primary, alternate string) {
+	k.appendPrimary(primary)
+	k.appendAlternate(alternate)
+}
+
+// doubleMetaphoneWord is a word being encoded, with bounds checked lookups.
+type doubleMetaphoneWord struct {
+	s             []rune
+	slavoGermanic bool
+}
+
+// at returns the rune at i, 0 out of bounds.
+func (w doubleMetaphoneWord) at(i int) rune {
+	if i < 0 || i >= len(w.s) {
+		return 0
+	}
+	return w.s[i]
+}
+
+// is reports whether the runes from start are one of the options, all of which have the same length.
+func (w doubleMetaphoneWord) is(start int, options ...string) bool {
+	n := len(options[0])
+	if start < 0 || start+n > len(w.s) {
+		return false
+	}
+	sub := string(w.s[start : start+n])
+	for _, o := range options {
+		if sub == o {
+			return true
+		}
+	}
+	return false
+}
+
+func (w doubleMetaphoneWord) vowel(i int) bool {
+	return strings.ContainsRune("AEIOUY", w.at(i))
+}
+
+func (w doubleMetaphoneWord) lastIndex() int {
+	return len(w.s) - 1
+}
+
+// germanic reports whether the word starts like a Germanic name.
+func (w doubleMetaphoneWord) germanic() bool {
+	return w.is(0, "VAN ", "VON ") || w.is(0, "SCH")
+}
+
+// skip returns the index after i, skipping a repetition of one of the letters.
+func (w doubleMetaphoneWord) skip(i int, letters ...string) int {
+	if w.is(i+1, letters...) {
+		return i + 2
+	}
+	return i + 1
+}
+
+// encode appends the code of the letter at i and returns the index of the next letter to encode.
+func (w doubleMetaphoneWord) encode(k *doubleMetaphoneKeys, i int) int {
+	switch w.s[i] {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		if i == 0 {
+			k.add("A")
+		}
+		return i + 1
+	case 'B':
+		k.add("P")
+		return w.skip(i, "B")
+	case 'Ç':
+		k.add("S")
+		return i + 1
+	case 'C':
+		return w.encodeC(k, i)
+	case 'D':
+		return w.encodeD(k, i)
+	case 'F':
+		k.add("F")
+		return w.skip(i, "F")
+	case 'G':
+		return w.encodeG(k, i)
+	case 'H':
+		// kept only at the start or between vowels
+		if (i == 0 || w.vowel(i-1)) && w.vowel(i+1) {
+			k.add("H")
+			return i + 2
+		}
+		return i + 1
+	case 'J':
+		return w.encodeJ(k, i)
+	case 'K':
+		k.add("K")
+		return w.skip(i, "K")
+	case 'L':
+		if w.at(i+1) == 'L' {
+			if w.spanishLL(i) {
+				k.appendPrimary("L")
+			} else {
+				k.add("L")
+			}
+			return i + 2
+		}
+		k.add("L")
+		return i + 1
+	case 'M':
+		k.add("M")
+		// "dumb", "thumb"
+		if w.at(i+1) == 'M' || (w.is(i-1, "UMB") && (i+1 == w.lastIndex() || w.is(i+2, "ER"))) {
+			return i + 2
+		}
+		return i + 1
+	case 'N':
+		k.add("N")
+		return w.skip(i, "N")
+	case 'Ñ':
+		k.add("N")
+		return i + 1
+	case 'P':
+		if w.at(i+1) == 'H' {
+			k.add("F")
+			return i + 2
+		}
+		k.add("P")
+		return w.skip(i, "P", "B")
+	case 'Q':
+		k.add("K")
+		return w.skip(i, "Q")
+	case 'R':
+		// French "Rogier"
+		if i == w.lastIndex() && !w.slavoGermanic && w.is(i-2, "IE") && !w.is(i-4, "ME", "MA") {
+			k.appendAlternate("R")
+		} else {
+			k.add("R")
+		}
+		return w.skip(i, "R")
+	case 'S':
+		return w.encodeS(k, i)
+	case 'T':
+		return w.encodeT(k, i)
+	case 'V':
+		k.add("F")
+		return w.skip(i, "V")
+	case 'W':
+		return w.encodeW(k, i)
+	case 'X':
+		if i == 0 {
+			k.add("S")
+			return i + 1
+		}
+		// French "Breaux"
+		if !(i == w.lastIndex() && (w.is(i-3, "IAU", "EAU") || w.is(i-2, "AU", "OU"))) {
+			k.add("KS")
+		}
+		return w.skip(i, "C", "X")
+	case 'Z':
+		// Chinese pinyin "Zhao"
+		if w.at(i+1) == 'H' {
+			k.add("J")
+			return i + 2
+		}
+		if w.is(i+1, "ZO", "ZI", "ZA") || (w.slavoGermanic && i > 0 && w.at(i-1) != 'T') {
+			k.add2("S", "TS")
+		} else {
+			k.add("S")
+		}
+		return w.skip(i, "Z")
+	}
+	return i + 1
+}
+
+func (w doubleMetaphoneWord) encodeC(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.germanicCH(i):
+		// "Bacher", "Macher"
+		k.add("K")
+		return i + 2
+	case i == 0 && w.is(i, "CAESAR"):
+		k.add("S")
+		return i + 2
+	case w.is(i, "CH"):
+		return w.encodeCH(k, i)
+	case w.is(i, "CZ") && !w.is(i-2, "WICZ"):
+		// "Czerny"
+		k.add2("S", "X")
+		return i + 2
+	case w.is(i+1, "CIA"):
+		// "focaccia"
+		k.add("X")
+		return i + 3
+	case w.is(i, "CC") && !(i == 1 && w.at(0) == 'M'):
+		// double C but not "McClelland"
+		if w.is(i+2, "I", "E", "H") && !w.is(i+2, "HU") {
+			// "Bellocchio" but not "Bacchus"
+			if (i == 1 && w.at(0) == 'A') || w.is(i-1, "UCCEE", "UCCES") {
+				// "accident", "accede", "succeed"
+				k.add("KS")
+			} else {
+				// "Bacci", "Bertucci"
+				k.add("X")
+			}
+			return i + 3
+		}
+		// Pierce's rule
+		k.add("K")
+		return i + 2
+	case w.is(i, "CK", "CG", "CQ"):
+		k.add("K")
+		return i + 2
+	case w.is(i, "CI", "CE", "CY"):
+		// Italian and English
+		if w.is(i, "CIO", "CIE", "CIA") {
+			k.add2("S", "X")
+		} else {
+			k.add("S")
+		}
+		return i + 2
+	}
+	k.add("K")
+	switch {
+	case w.is(i+1, " C", " Q", " G"):
+		// "Mac Caffrey", "Mac Gregor"
+		return i + 3
+	case w.is(i+1, "C", "K", "Q") && !w.is(i+1, "CE", "CI"):
+		return i + 2
+	}
+	return i + 1
+}
+
+// germanicCH reports whether the C at i is hard in a Germanic ACH not followed by I or E.
+func (w doubleMetaphoneWord) germanicCH(i int) bool {
+	switch {
+	case w.is(i, "CHIA"):
+		return true
+	case i <= 1, w.vowel(i - 2), !w.is(i-1, "ACH"):
+		return false
+	}
+	c := w.at(i + 2)
+	return (c != 'I' && c != 'E') || w.is(i-2, "BACHER", "MACHER")
+}
+
+func (w doubleMetaphoneWord) encodeCH(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case i > 0 && w.is(i, "CHAE"):
+		// "Michael"
+		k.add2("K", "X")
+	case i == 0 && (w.is(i+1, "HARAC", "HARIS") || w.is(i+1, "HOR", "HYM", "HIA", "HEM")) && !w.is(0, "CHORE"):
+		// Greek roots, "chemistry", "chorus"
+		k.add("K")
+	case w.germanic(), w.is(i-2, "ORCHES", "ARCHIT", "ORCHID"), w.is(i+1, "T", "S"),
+		(w.is(i-1, "A", "O", "U", "E") || i == 0) &&
+			(w.is(i+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || i+1 == w.lastIndex()):
+		// Germanic, Greek or otherwise CH for KH sound
+		k.add("K")
+	case i > 0 && w.is(0, "MC"):
+		k.add("K")
+	case i > 0:
+		k.add2("X", "K")
+	default:
+		k.add("X")
+	}
+	return i + 2
+}
+
+func (w doubleMetaphoneWord) encodeD(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.is(i, "DG"):
+		if w.is(i+2, "I", "E", "Y") {
+			// "edge"
+			k.add("J")
+			return i + 3
+		}
+		// "Edgar"
+		k.add("TK")
+		return i + 2
+	case w.is(i, "DT", "DD"):
+		k.add("T")
+		return i + 2
+	}
+	k.add("T")
+	return i + 1
+}
+
+func (w doubleMetaphoneWord) encodeG(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.at(i+1) == 'H':
+		return w.encodeGH(k, i)
+	case w.at(i+1) == 'N':
+		switch {
+		case i == 1 && w.vowel(0) && !w.slavoGermanic:
+			k.add2("KN", "N")
+		case !w.is(i+2, "EY") && w.at(i+1) != 'Y' && !w.slavoGermanic:
+			// not "Cagney"
+			k.add2("N", "KN")
+		default:
+			k.add("KN")
+		}
+		return i + 2
+	case w.is(i+1, "LI") && !w.slavoGermanic:
+		// "tagliaro"
+		k.add2("KL", "L")
+		return i + 2
+	case i == 0 && (w.at(i+1) == 'Y' || w.is(i+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		// -ges-, -gep-, -gel-, -gie- at the beginning
+		k.add2("K", "J")
+		return i + 2
+	case (w.is(i+1, "ER") || w.at(i+1) == 'Y') && !w.is(0, "DANGER", "RANGER", "MANGER") &&
+		!w.is(i-1, "E", "I") && !w.is(i-1, "RGY", "OGY"):
+		// -ger-, -gy-
+		k.add2("K", "J")
+		return i + 2
+	case w.is(i+1, "E", "I", "Y") || w.is(i-1, "AGGI", "OGGI"):
+		// Italian "Biaggi"
+		switch {
+		case w.germanic() || w.is(i+1, "ET"):
+			k.add("K")
+		case w.is(i+1, "IER"):
+			k.add("J")
+		default:
+			k.add2("J", "K")
+		}
+		return i + 2
+	case w.at(i+1) == 'G':
+		k.add("K")
+		return i + 2
+	}
+	k.add("K")
+	return i + 1
+}
+
+func (w doubleMetaphoneWord) encodeGH(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case i > 0 && !w.vowel(i-1):
+		k.add("K")
+	case i == 0:
+		// "ghislane", "ghiradelli"
+		if w.at(i+2) == 'I' {
+			k.add("J")
+		} else {
+			k.add("K")
+		}
+	case (i > 1 && w.is(i-2, "B", "H", "D")) || (i > 2 && w.is(i-3, "B", "H", "D")) || (i > 3 && w.is(i-4, "B", "H")):
+		// Parker's rule, "Hugh"
+	case i > 2 && w.at(i-1) == 'U' && w.is(i-3, "C", "G", "L", "R", "T"):
+		// "laugh", "McLaughlin", "cough", "gough", "rough", "tough"
+		k.add("F")
+	case w.at(i-1) != 'I':
+		k.add("K")
+	}
+	return i + 2
+}
+
+func (w doubleMetaphoneWord) encodeJ(k *doubleMetaphoneKeys, i int) int {
+	if w.is(i, "JOSE") || w.is(0, "SAN ") {
+		// Spanish "Jose", "San Jacinto"
+		if (i == 0 && w.at(i+4) == ' ') || len(w.s) == 4 || w.is(0, "SAN ") {
+			k.add("H")
+		} else {
+			k.add2("J", "H")
+		}
+		return i + 1
+	}
+	switch {
+	case i == 0:
+		// "Yankelovich", "Jankelowicz"
+		k.add2("J", "A")
+	case w.vowel(i-1) && !w.slavoGermanic && (w.at(i+1) == 'A' || w.at(i+1) == 'O'):
+		// Spanish "bajador"
+		k.add2("J", "H")
+	case i == w.lastIndex():
+		k.add2("J", "")
+	case !w.is(i+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !w.is(i-1, "S", "K", "L"):
+		k.add("J")
+	}
+	return w.skip(i, "J")
+}
+
+// spanishLL reports whether the LL at i is Spanish, as in "cabrillo" and "gallegos".
+func (w doubleMetaphoneWord) spanishLL(i int) bool {
+	if i == len(w.s)-3 && w.is(i-1, "ILLO", "ILLA", "ALLE") {
+		return true
+	}
+	return (w.is(len(w.s)-2, "AS", "OS") || w.is(len(w.s)-1, "A", "O")) && w.is(i-1, "ALLE")
+}
+
+func (w doubleMetaphoneWord) encodeS(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.is(i-1, "ISL", "YSL"):
+		// "island", "isle", "Carlisle", "Carlysle"
+		return i + 1
+	case i == 0 && w.is(i, "SUGAR"):
+		k.add2("X", "S")
+		return i + 1
+	case w.is(i, "SH"):
+		if w.is(i+1, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			// Germanic
+			k.add("S")
+		} else {
+			k.add("X")
+		}
+		return i + 2
+	case w.is(i, "SIO", "SIA") || w.is(i, "SIAN"):
+		// Italian and Armenian
+		if w.slavoGermanic {
+			k.add("S")
+		} else {
+			k.add2("S", "X")
+		}
+		return i + 3
+	case (i == 0 && w.is(i+1, "M", "N", "L", "W")) || w.is(i+1, "Z"):
+		// "Smith" matches "Schmidt", "Snider" matches "Schneider", Slavic -sz-
+		k.add2("S", "X")
+		return w.skip(i, "Z")
+	case w.is(i, "SC"):
+		return w.encodeSC(k, i)
+	}
+	if i == w.lastIndex() && w.is(i-2, "AI", "OI") {
+		// French "Resnais", "Artois"
+		k.appendAlternate("S")
+	} else {
+		k.add("S")
+	}
+	return w.skip(i, "S", "Z")
+}
+
+func (w doubleMetaphoneWord) encodeSC(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.at(i+2) == 'H':
+		// Schlesinger's rule
+		switch {
+		case w.is(i+3, "ER", "EN"):
+			// "Schermerhorn", "Schenker"
+			k.add2("X", "SK")
+		case w.is(i+3, "OO", "UY", "ED", "EM"):
+			// Dutch "school", "schooner"
+			k.add("SK")
+		case i == 0 && !w.vowel(3) && w.at(3) != 'W':
+			k.add2("X", "S")
+		default:
+			k.add("X")
+		}
+	case w.is(i+2, "I", "E", "Y"):
+		k.add("S")
+	default:
+		k.add("SK")
+	}
+	return i + 3
+}
+
+func (w doubleMetaphoneWord) encodeT(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.is(i, "TION"), w.is(i, "TIA", "TCH"):
+		k.add("X")
+		return i + 3
+	case w.is(i, "TH") || w.is(i, "TTH"):
+		// "Thomas", "Thames" or Germanic
+		if w.is(i+2, "OM", "AM") || w.germanic() {
+			k.add("T")
+		} else {
+			k.add2("0", "T")
+		}
+		return i + 2
+	}
+	k.add("T")
+	return w.skip(i, "T", "D")
+}
+
+func (w doubleMetaphoneWord) encodeW(k *doubleMetaphoneKeys, i int) int {
+	switch {
+	case w.is(i, "WR"):
+		k.add("R")
+		return i + 2
+	case i == 0 && w.vowel(i+1):
+		// "Wasserman" matches "Vasserman"
+		k.add2("A", "F")
+	case i == 0 && w.is(i, "WH"):
+		// "Uomo" matches "Womo"
+		k.add("A")
+	case (i == w.lastIndex() && w.vowel(i-1)) || w.is(i-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || w.is(0, "SCH"):
+		// "Arnow" matches "Arnoff"
+		k.appendAlternate("F")
+	case w.is(i, "WICZ", "WITZ"):
+		// Polish "Filipowicz"
+		k.add2("TS", "FX")
+		return i + 4
+	}
+	return i + 1
+}
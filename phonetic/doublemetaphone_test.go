@@ -0,0 +1,66 @@
+package phonetic
+
+import "testing"
+
+func TestDoubleMetaphone_Encodings(t *testing.T) {
+	tests := []struct {
+		name      string
+		m         DoubleMetaphone
+		s         string
+		primary   string
+		alternate string
+	}{
+		{"empty", DoubleMetaphone{}, "", "", ""},
+		{"blank", DoubleMetaphone{}, "  ", "", ""},
+		{"Smith", DoubleMetaphone{}, "Smith", "SM0", "XMT"},
+		{"Schmidt", DoubleMetaphone{}, "Schmidt", "XMT", "SMT"},
+		{"Thompson", DoubleMetaphone{}, "Thompson", "TMPS", "TMPS"},
+		{"Jose", DoubleMetaphone{}, "Jose", "HS", "HS"},
+		{"San Jacinto", DoubleMetaphone{}, "San Jacinto", "SNHS", "SNHS"},
+		{"Michael", DoubleMetaphone{}, "Michael", "MKL", "MXL"},
+		{"Czerny", DoubleMetaphone{}, "Czerny", "SRN", "XRN"},
+		{"focaccia", DoubleMetaphone{}, "focaccia", "FKX", "FKX"},
+		{"accident", DoubleMetaphone{}, "accident", "AKST", "AKST"},
+		{"Caesar", DoubleMetaphone{}, "Caesar", "SSR", "SSR"},
+		{"chemistry", DoubleMetaphone{}, "chemistry", "KMST", "KMST"},
+		{"McHugh", DoubleMetaphone{}, "McHugh", "MK", "MK"},
+		{"edge", DoubleMetaphone{}, "edge", "AJ", "AJ"},
+		{"Edgar", DoubleMetaphone{}, "Edgar", "ATKR", "ATKR"},
+		{"laugh", DoubleMetaphone{}, "laugh", "LF", "LF"},
+		{"tagliaro", DoubleMetaphone{}, "tagliaro", "TKLR", "TLR"},
+		{"gerald", DoubleMetaphone{}, "gerald", "KRLT", "JRLT"},
+		{"Jankelowicz", DoubleMetaphone{}, "Jankelowicz", "JNKL", "ANKL"},
+		{"bajador", DoubleMetaphone{}, "bajador", "PJTR", "PHTR"},
+		{"cabrillo", DoubleMetaphone{}, "cabrillo", "KPRL", "KPR"},
+		{"gallegos", DoubleMetaphone{}, "gallegos", "KLKS", "KKS"},
+		{"island", DoubleMetaphone{}, "island", "ALNT", "ALNT"},
+		{"sugar", DoubleMetaphone{}, "sugar", "XKR", "SKR"},
+		{"Resnais", DoubleMetaphone{}, "Resnais", "RSN", "RSNS"},
+		{"school", DoubleMetaphone{}, "school", "SKL", "SKL"},
+		{"Thomas", DoubleMetaphone{}, "Thomas", "TMS", "TMS"},
+		{"Wasserman", DoubleMetaphone{}, "Wasserman", "ASRM", "FSRM"},
+		{"Arnow", DoubleMetaphone{}, "Arnow", "ARN", "ARNF"},
+		{"Filipowicz", DoubleMetaphone{}, "Filipowicz", "FLPT", "FLPF"},
+		{"Breaux", DoubleMetaphone{}, "Breaux", "PR", "PR"},
+		{"Zhao", DoubleMetaphone{}, "Zhao", "J", "J"},
+		{"Xavier", DoubleMetaphone{}, "Xavier", "SF", "SFR"},
+		{"silent start", DoubleMetaphone{}, "Gnome", "NM", "NM"},
+		{"cedilla", DoubleMetaphone{}, "Ça va", "SF", "SF"},
+		{"tilde", DoubleMetaphone{}, "Niño", "NN", "NN"},
+		{"no limit", DoubleMetaphone{MaxLength: -1}, "Jankelowicz", "JNKLTS", "ANKLFX"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, alternate := tt.m.Encodings(tt.s)
+			if primary != tt.primary {
+				t.Errorf("DoubleMetaphone.Encodings() primary = %v, want %v", primary, tt.primary)
+			}
+			if alternate != tt.alternate {
+				t.Errorf("DoubleMetaphone.Encodings() alternate = %v, want %v", alternate, tt.alternate)
+			}
+			if got := tt.m.Encode(tt.s); got != tt.primary {
+				t.Errorf("DoubleMetaphone.Encode() = %v, want %v", got, tt.primary)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package phonetic
+
+import "strings"
+
+// Metaphone is Lawrence Philips' original Metaphone, which codes the consonant sounds of English
+// words with 16 consonant symbols, "0" standing for "th". Vowels are kept only at the start.
+type Metaphone struct {
+	// MaxLength is the length the key is truncated to, 0 means 4 and a negative value no limit.
+	MaxLength int
+}
+
+// Encode Returns the Metaphone key of s.
+//  phonetic.Metaphone{}.Encode("")         = ""
+//  phonetic.Metaphone{}.Encode("Thompson") = "0MPS"
+//  phonetic.Metaphone{}.Encode("knight")   = "NT"
+//  phonetic.Metaphone{}.Encode("quick")    = "KK"
+//  phonetic.Metaphone{}.Encode("testing")  = "TSTN"
+func (m Metaphone) Encode(s string) string {
+	s = letters(s, nil)
+	if len(s) <= 1 {
+		return s
+	}
+	limit := maxLength(m.MaxLength, 4)
+
+	// initial letter exceptions
+	switch {
+	case (s[0] == 'K' || s[0] == 'G' || s[0] == 'P') && s[1] == 'N',
+		s[0] == 'A' && s[1] == 'E',
+		s[0] == 'W' && s[1] == 'R':
+		s = s[1:]
+	case s[0] == 'W' && s[1] == 'H':
+		s = "W" + s[2:]
+	case s[0] == 'X':
+		s = "S" + s[1:]
+	}
+
+	w := metaphoneWord(s)
+	var code strings.Builder
+	for n := 0; n < len(s) && code.Len() < limit; n++ {
+		c := s[n]
+		// duplicate letters except C are coded once
+		if c != 'C' && w.prev(n, c) {
+			continue
+		}
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if n == 0 {
+				code.WriteByte(c)
+			}
+		case 'B':
+			// silent in a final MB
+			if !(w.prev(n, 'M') && w.last(n)) {
+				code.WriteByte('B')
+			}
+		case 'C':
+			switch {
+			case w.prev(n, 'S') && !w.last(n) && w.frontVowel(n+1):
+				// silent in SCI, SCE and SCY
+			case w.at(n, "CIA"):
+				code.WriteByte('X')
+			case !w.last(n) && w.frontVowel(n+1):
+				code.WriteByte('S')
+			case w.prev(n, 'S') && w.next(n, 'H'):
+				code.WriteByte('K')
+			case w.next(n, 'H'):
+				if n == 0 && len(s) >= 3 && w.vowel(2) {
+					code.WriteByte('K')
+				} else {
+					code.WriteByte('X')
+				}
+			default:
+				code.WriteByte('K')
+			}
+		case 'D':
+			if !w.last(n+1) && w.next(n, 'G') && w.frontVowel(n+2) {
+				// DGE, DGI and DGY
+				code.WriteByte('J')
+				n += 2
+			} else {
+				code.WriteByte('T')
+			}
+		case 'G':
+			switch {
+			case w.last(n+1) && w.next(n, 'H'),
+				!w.last(n+1) && w.next(n, 'H') && !w.vowel(n+2),
+				n > 0 && (w.at(n, "GN") || w.at(n, "GNED")):
+				// silent GH at the end or before a consonant, silent GN
+			case !w.last(n) && w.frontVowel(n+1):
+				code.WriteByte('J')
+			default:
+				code.WriteByte('K')
+			}
+		case 'H':
+			if !w.last(n) && !(n > 0 && strings.IndexByte("CSPTG", s[n-1]) >= 0) && w.vowel(n+1) {
+				code.WriteByte('H')
+			}
+		case 'F', 'J', 'L', 'M', 'N', 'R':
+			code.WriteByte(c)
+		case 'K':
+			if !w.prev(n, 'C') {
+				code.WriteByte('K')
+			}
+		case 'P':
+			if w.next(n, 'H') {
+				code.WriteByte('F')
+			} else {
+				code.WriteByte('P')
+			}
+		case 'Q':
+			code.WriteByte('K')
+		case 'S':
+			if w.at(n, "SH") || w.at(n, "SIO") || w.at(n, "SIA") {
+				code.WriteByte('X')
+			} else {
+				code.WriteByte('S')
+			}
+		case 'T':
+			switch {
+			case w.at(n, "TIA") || w.at(n, "TIO"):
+				code.WriteByte('X')
+			case w.at(n, "TCH"):
+			case w.at(n, "TH"):
+				code.WriteByte('0')
+			default:
+				code.WriteByte('T')
+			}
+		case 'V':
+			code.WriteByte('F')
+		case 'W', 'Y':
+			// silent unless followed by a vowel
+			if !w.last(n) && w.vowel(n+1) {
+				code.WriteByte(c)
+			}
+		case 'X':
+			code.WriteString("KS")
+		case 'Z':
+			code.WriteByte('S')
+		}
+	}
+	key := code.String()
+	if len(key) > limit {
+		key = key[:limit]
+	}
+	return key
+}
+
+// metaphoneWord is a word being encoded, with bounds checked lookups.
+type metaphoneWord string
+
+func (w metaphoneWord) last(n int) bool {
+	return n+1 == len(w)
+}
+
+func (w metaphoneWord) prev(n int, c byte) bool {
+	return n > 0 && n < len(w) && w[n-1] == c
+}
+
+func (w metaphoneWord) next(n int, c byte) bool {
+	return n >= 0 && n < len(w)-1 && w[n+1] == c
+}
+
+func (w metaphoneWord) at(n int, s string) bool {
+	return n >= 0 && strings.HasPrefix(string(w[n:]), s)
+}
+
+func (w metaphoneWord) vowel(n int) bool {
+	return n >= 0 && n < len(w) && strings.IndexByte("AEIOU", w[n]) >= 0
+}
+
+func (w metaphoneWord) frontVowel(n int) bool {
+	return n >= 0 && n < len(w) && strings.IndexByte("EIY", w[n]) >= 0
+}
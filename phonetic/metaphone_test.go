@@ -0,0 +1,43 @@
+package phonetic
+
+import "testing"
+
+func TestMetaphone_Encode(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Metaphone
+		s    string
+		want string
+	}{
+		{"empty", Metaphone{}, "", ""},
+		{"blank", Metaphone{}, " ", ""},
+		{"single letter", Metaphone{}, "a", "A"},
+		{"howl", Metaphone{}, "howl", "HL"},
+		{"testing", Metaphone{}, "testing", "TSTN"},
+		{"The", Metaphone{}, "The", "0"},
+		{"quick", Metaphone{}, "quick", "KK"},
+		{"brown", Metaphone{}, "brown", "BRN"},
+		{"fox", Metaphone{}, "fox", "FKS"},
+		{"jumped", Metaphone{}, "jumped", "JMPT"},
+		{"over", Metaphone{}, "over", "OFR"},
+		{"lazy", Metaphone{}, "lazy", "LS"},
+		{"dogs", Metaphone{}, "dogs", "TKS"},
+		{"initial KN", Metaphone{}, "knight", "NT"},
+		{"initial WR", Metaphone{}, "Wright", "RT"},
+		{"initial WH", Metaphone{}, "White", "WT"},
+		{"initial X", Metaphone{}, "Xavier", "SFR"},
+		{"SCI", Metaphone{}, "science", "SNS"},
+		{"CH", Metaphone{}, "character", "KRKT"},
+		{"DGE", Metaphone{}, "judge", "JJ"},
+		{"MB", Metaphone{}, "lamb", "LM"},
+		{"max length", Metaphone{MaxLength: 6}, "character", "KRKTR"},
+		{"no limit", Metaphone{MaxLength: -1}, "Washington", "WXNKTN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Encode(tt.s); got != tt.want {
+				t.Errorf("Metaphone.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
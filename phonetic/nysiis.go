@@ -0,0 +1,105 @@
+package phonetic
+
+import "strings"
+
+// NYSIIS is the New York State Identification and Intelligence System code, which keeps the
+// vowel positions as "A" and so tells apart more names than Soundex.
+type NYSIIS struct {
+	// MaxLength is the length the key is truncated to, 0 means 6 as in the original
+	// specification and a negative value no limit.
+	MaxLength int
+}
+
+// nysiisPrefixes and nysiisSuffixes are the translations of the first and the last letters.
+var (
+	nysiisPrefixes = []struct{ from, to string }{{"MAC", "MCC"}, {"KN", "NN"}, {"K", "C"}, {"PH", "FF"}, {"PF", "FF"}, {"SCH", "SSS"}}
+	nysiisSuffixes = []struct{ from, to string }{{"EE", "Y"}, {"IE", "Y"}, {"DT", "D"}, {"RT", "D"}, {"RD", "D"}, {"NT", "D"}, {"ND", "D"}}
+)
+
+// Encode Returns the NYSIIS key of s.
+//  phonetic.NYSIIS{}.Encode("")           = ""
+//  phonetic.NYSIIS{}.Encode("Brown")      = "BRAN"
+//  phonetic.NYSIIS{}.Encode("Knight")     = "NAGT"
+//  phonetic.NYSIIS{}.Encode("Macintosh")  = "MCANT"
+//  phonetic.NYSIIS{}.Encode("Schmidt")    = "SNAD"
+//  phonetic.NYSIIS{MaxLength: -1}.Encode("Phillipson") = "FALAPSAN"
+func (m NYSIIS) Encode(s string) string {
+	s = letters(s, nil)
+	if s == "" {
+		return ""
+	}
+	for _, t := range nysiisPrefixes {
+		if strings.HasPrefix(s, t.from) {
+			s = t.to + s[len(t.from):]
+			break
+		}
+	}
+	for _, t := range nysiisSuffixes {
+		if strings.HasSuffix(s, t.from) {
+			s = s[:len(s)-len(t.from)] + t.to
+			break
+		}
+	}
+
+	rs := []byte(s)
+	key := []byte{rs[0]}
+	for i := 1; i < len(rs); i++ {
+		next, afterNext := byte(' '), byte(' ')
+		if i+1 < len(rs) {
+			next = rs[i+1]
+		}
+		if i+2 < len(rs) {
+			afterNext = rs[i+2]
+		}
+		copy(rs[i:], nysiisTranscode(rs[i-1], rs[i], next, afterNext))
+		if rs[i] != rs[i-1] {
+			key = append(key, rs[i])
+		}
+	}
+
+	if len(key) > 1 {
+		last := key[len(key)-1]
+		if last == 'S' {
+			key = key[:len(key)-1]
+			last = key[len(key)-1]
+		}
+		if len(key) > 2 && key[len(key)-2] == 'A' && last == 'Y' {
+			key = append(key[:len(key)-2], 'Y')
+		}
+		if last == 'A' {
+			key = key[:len(key)-1]
+		}
+	}
+	if limit := maxLength(m.MaxLength, 6); len(key) > limit {
+		key = key[:limit]
+	}
+	return string(key)
+}
+
+// nysiisTranscode returns the letters replacing cur, given the letters around it.
+func nysiisTranscode(prev, cur, next, afterNext byte) string {
+	vowel := func(c byte) bool { return strings.IndexByte("AEIOU", c) >= 0 }
+	switch {
+	case cur == 'E' && next == 'V':
+		return "AF"
+	case vowel(cur):
+		return "A"
+	case cur == 'Q':
+		return "G"
+	case cur == 'Z':
+		return "S"
+	case cur == 'M':
+		return "N"
+	case cur == 'K' && next == 'N':
+		return "NN"
+	case cur == 'K':
+		return "C"
+	case cur == 'S' && next == 'C' && afterNext == 'H':
+		return "SSS"
+	case cur == 'P' && next == 'H':
+		return "FF"
+	case cur == 'H' && (!vowel(prev) || !vowel(next)), cur == 'W' && vowel(prev):
+		return string(prev)
+	}
+	return string(cur)
+}
@@ -0,0 +1,40 @@
+package phonetic
+
+import "testing"
+
+func TestNYSIIS_Encode(t *testing.T) {
+	tests := []struct {
+		name string
+		m    NYSIIS
+		s    string
+		want string
+	}{
+		{"empty", NYSIIS{}, "", ""},
+		{"blank", NYSIIS{}, " ", ""},
+		{"Bishop", NYSIIS{}, "Bishop", "BASAP"},
+		{"Carlson", NYSIIS{}, "Carlson", "CARLSA"},
+		{"Carr", NYSIIS{}, "Carr", "CAR"},
+		{"Chapman", NYSIIS{}, "Chapman", "CAPNAN"},
+		{"Brian", NYSIIS{}, "Brian", "BRAN"},
+		{"Brown", NYSIIS{}, "Brown", "BRAN"},
+		{"Dane", NYSIIS{}, "Dane", "DAN"},
+		{"Dent", NYSIIS{}, "Dent", "DAD"},
+		{"Knight", NYSIIS{}, "Knight", "NAGT"},
+		{"Mitchell", NYSIIS{}, "Mitchell", "MATCAL"},
+		{"O'Daniel", NYSIIS{}, "O'Daniel", "ODANAL"},
+		{"Schmidt", NYSIIS{}, "Schmidt", "SNAD"},
+		{"Smith", NYSIIS{}, "Smith", "SNAT"},
+		{"Macintosh", NYSIIS{}, "Macintosh", "MCANT"},
+		{"Jay", NYSIIS{}, "Jay", "JY"},
+		{"Kassidy", NYSIIS{}, "Kassidy", "CASADY"},
+		{"no limit", NYSIIS{MaxLength: -1}, "Phillipson", "FALAPSAN"},
+		{"no limit Carlson", NYSIIS{MaxLength: -1}, "Carlson", "CARLSAN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Encode(tt.s); got != tt.want {
+				t.Errorf("NYSIIS.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
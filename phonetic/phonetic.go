@@ -0,0 +1,63 @@
+// Package phonetic provides phonetic encoders, which map names and words to keys such that
+// words which sound alike in English share a key. Letters are upper-cased and transliterated
+// to ASCII before encoding, other characters are ignored.
+package phonetic
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Zarket/stringutils"
+)
+
+// Encoder computes the phonetic key of a string.
+type Encoder interface {
+	// Encode returns the key of s, "" if s is blank or has no letters the encoder can encode.
+	Encode(s string) string
+}
+
+// IsSoundsLike Checks if a and b have the same non-empty key with the encoder.
+//  phonetic.IsSoundsLike("Robert", "Rupert", phonetic.Soundex{}) = true
+//  phonetic.IsSoundsLike("Robert", "Robin", phonetic.Soundex{})  = false
+//  phonetic.IsSoundsLike("", "", phonetic.Soundex{})             = false
+func IsSoundsLike(a, b string, e Encoder) bool {
+	key := e.Encode(a)
+	return key != "" && key == e.Encode(b)
+}
+
+// letters returns the upper case ASCII letters of s, non-ASCII letters are transliterated first.
+// Runes for which keep returns true are kept as they are, upper-cased.
+func letters(s string, keep func(rune) bool) string {
+	if stringutils.IsBlank(s) {
+		return ""
+	}
+	var b strings.Builder
+	for _, r := range s {
+		r = unicode.ToUpper(r)
+		if keep != nil && keep(r) {
+			b.WriteRune(r)
+			continue
+		}
+		t := string(r)
+		if r > unicode.MaxASCII {
+			t, _ = stringutils.ToASCII(t)
+		}
+		for i := 0; i < len(t); i++ {
+			if c := t[i] &^ 0x20; c >= 'A' && c <= 'Z' {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return b.String()
+}
+
+// maxLength returns n, or def for 0, or no limit for negative n.
+func maxLength(n, def int) int {
+	switch {
+	case n == 0:
+		return def
+	case n < 0:
+		return int(^uint(0) >> 1)
+	}
+	return n
+}
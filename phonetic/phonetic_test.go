@@ -0,0 +1,56 @@
+package phonetic
+
+import "testing"
+
+func TestIsSoundsLike(t *testing.T) {
+	type args struct {
+		a string
+		b string
+		e Encoder
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", "", Soundex{}}, false},
+		{"blank", args{" ", "\t", Metaphone{}}, false},
+		{"no letters", args{"123", "456", NYSIIS{}}, false},
+		{"Robert,Rupert", args{"Robert", "Rupert", Soundex{}}, true},
+		{"Robert,Robin", args{"Robert", "Robin", Soundex{}}, false},
+		{"Smith,Smyth", args{"Smith", "Smyth", Metaphone{}}, true},
+		{"Brian,Brown", args{"Brian", "Brown", NYSIIS{}}, true},
+		{"Catherine,Kathryn", args{"Catherine", "Kathryn", DoubleMetaphone{}}, true},
+		{"accents", args{"Müller", "Muller", RefinedSoundex{}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSoundsLike(tt.args.a, tt.args.b, tt.args.e); got != tt.want {
+				t.Errorf("IsSoundsLike() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_letters(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"blank", " \t ", ""},
+		{"ascii", "O'Brien-Smith", "OBRIENSMITH"},
+		{"accents", "Ångström", "ANGSTROM"},
+		{"ligature", "Straße", "STRASSE"},
+		{"no letters", "12 34", ""},
+		{"untransliterable", "日本", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := letters(tt.s, nil); got != tt.want {
+				t.Errorf("letters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
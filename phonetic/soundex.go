@@ -0,0 +1,68 @@
+package phonetic
+
+import "strings"
+
+// soundexCodes maps the letters A to Z to their American Soundex digits, '0' is not coded.
+const soundexCodes = "01230120022455012623010202"
+
+// refinedSoundexCodes maps the letters A to Z to their Refined Soundex digits.
+const refinedSoundexCodes = "01360240043788015936020505"
+
+// Soundex is the American Soundex as used by the U.S. census: the first letter followed by
+// three digits for the following consonants. Adjacent letters with the same digit, also when
+// separated by H or W, are coded once.
+type Soundex struct{}
+
+// Encode Returns the Soundex key of s.
+//  phonetic.Soundex{}.Encode("")         = ""
+//  phonetic.Soundex{}.Encode("Robert")   = "R163"
+//  phonetic.Soundex{}.Encode("Rupert")   = "R163"
+//  phonetic.Soundex{}.Encode("Ashcraft") = "A261"
+//  phonetic.Soundex{}.Encode("Tymczak")  = "T522"
+//  phonetic.Soundex{}.Encode("Lee")      = "L000"
+func (Soundex) Encode(s string) string {
+	s = letters(s, nil)
+	if s == "" {
+		return ""
+	}
+	b := []byte{s[0]}
+	last := soundexCodes[s[0]-'A']
+	for i := 1; i < len(s) && len(b) < 4; i++ {
+		if s[i] == 'H' || s[i] == 'W' {
+			continue
+		}
+		code := soundexCodes[s[i]-'A']
+		if code != '0' && code != last {
+			b = append(b, code)
+		}
+		last = code
+	}
+	return string(b) + strings.Repeat("0", 4-len(b))
+}
+
+// RefinedSoundex is the Refined Soundex: the first letter followed by a digit for every letter,
+// vowels included, in finer groups than Soundex. Adjacent letters with the same digit are coded once.
+// The key is not truncated.
+type RefinedSoundex struct{}
+
+// Encode Returns the Refined Soundex key of s.
+//  phonetic.RefinedSoundex{}.Encode("")        = ""
+//  phonetic.RefinedSoundex{}.Encode("testing") = "T6036084"
+//  phonetic.RefinedSoundex{}.Encode("Braz")    = "B1905"
+//  phonetic.RefinedSoundex{}.Encode("Caren")   = "C30908"
+func (RefinedSoundex) Encode(s string) string {
+	s = letters(s, nil)
+	if s == "" {
+		return ""
+	}
+	b := []byte{s[0]}
+	last := byte(0)
+	for i := 0; i < len(s); i++ {
+		code := refinedSoundexCodes[s[i]-'A']
+		if code != last {
+			b = append(b, code)
+		}
+		last = code
+	}
+	return string(b)
+}
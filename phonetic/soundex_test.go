@@ -0,0 +1,64 @@
+package phonetic
+
+import "testing"
+
+func TestSoundex_Encode(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"blank", "  ", ""},
+		{"no letters", "42", ""},
+		{"Robert", "Robert", "R163"},
+		{"Rupert", "Rupert", "R163"},
+		{"Rubin", "Rubin", "R150"},
+		{"Ashcraft", "Ashcraft", "A261"},
+		{"Ashcroft", "Ashcroft", "A261"},
+		{"Tymczak", "Tymczak", "T522"},
+		{"Pfister", "Pfister", "P236"},
+		{"Honeyman", "Honeyman", "H555"},
+		{"Lee", "Lee", "L000"},
+		{"lower case", "washington", "W252"},
+		{"punctuation", "O'Hara", "O600"},
+		{"accents", "Çelik", "C420"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Soundex{}).Encode(tt.s); got != tt.want {
+				t.Errorf("Soundex.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefinedSoundex_Encode(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"blank", "  ", ""},
+		{"testing", "testing", "T6036084"},
+		{"TESTING", "TESTING", "T6036084"},
+		{"The", "The", "T60"},
+		{"quick", "quick", "Q503"},
+		{"brown", "brown", "B1908"},
+		{"fox", "fox", "F205"},
+		{"jumped", "jumped", "J408106"},
+		{"over", "over", "O0209"},
+		{"lazy", "lazy", "L7050"},
+		{"dogs", "dogs", "D6043"},
+		{"Braz", "Braz", "B1905"},
+		{"Caren", "Caren", "C30908"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (RefinedSoundex{}).Encode(tt.s); got != tt.want {
+				t.Errorf("RefinedSoundex.Encode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
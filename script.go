@@ -0,0 +1,204 @@
+package stringutils
+
+import (
+	"sort"
+	"sync"
+	"unicode"
+)
+
+// scriptRange is a run of code points sharing one Script.
+type scriptRange struct {
+	lo, hi rune
+	name   string
+}
+
+var (
+	scriptOnce  sync.Once
+	scriptTable []scriptRange
+)
+
+// loadScripts builds a table of the Script ranges of unicode.Scripts sorted by code point.
+func loadScripts() {
+	for name, t := range unicode.Scripts {
+		for _, r := range t.R16 {
+			scriptTable = appendScriptRange(scriptTable, rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+		for _, r := range t.R32 {
+			scriptTable = appendScriptRange(scriptTable, rune(r.Lo), rune(r.Hi), rune(r.Stride), name)
+		}
+	}
+	sort.Slice(scriptTable, func(i, j int) bool { return scriptTable[i].lo < scriptTable[j].lo })
+}
+
+// appendScriptRange appends the code points from lo to hi in steps of stride.
+func appendScriptRange(table []scriptRange, lo, hi, stride rune, name string) []scriptRange {
+	if stride == 1 {
+		return append(table, scriptRange{lo, hi, name})
+	}
+	for r := lo; r <= hi; r += stride {
+		table = append(table, scriptRange{r, r, name})
+	}
+	return table
+}
+
+// scriptOf returns the name of the Script of the rune as in unicode.Scripts, "" if it is unassigned.
+func scriptOf(r rune) string {
+	scriptOnce.Do(loadScripts)
+	i := sort.Search(len(scriptTable), func(i int) bool { return scriptTable[i].hi >= r })
+	if i < len(scriptTable) && scriptTable[i].lo <= r {
+		return scriptTable[i].name
+	}
+	return ""
+}
+
+// isSpecificScript reports whether the script is a writing system, not Common or Inherited,
+// which are used with every script, nor the one of unassigned code points.
+func isSpecificScript(name string) bool {
+	return name != "" && name != "Common" && name != "Inherited"
+}
+
+// Scripts Returns the number of runes of s of every script, by the script names of unicode.Scripts.
+// Common and Inherited characters such as digits, punctuation and combining marks are not counted,
+// nor whitespace as defined by IsBlank.
+//  stringutils.Scripts("")            = map[]
+//  stringutils.Scripts("123 !")       = map[]
+//  stringutils.Scripts("paypal")      = map[Latin:6]
+//  stringutils.Scripts("p\u0430ypal") = map[Cyrillic:1 Latin:5]
+func Scripts(s string) map[string]int {
+	counts := make(map[string]int)
+	for _, r := range s {
+		if name, ok := runeScript(r); ok {
+			counts[name]++
+		}
+	}
+	return counts
+}
+
+// runeScript returns the name of the script of the rune, false if it is whitespace or has no script
+// of its own.
+func runeScript(r rune) (string, bool) {
+	if unicode.IsSpace(r) || IsInformationSeparator(r) {
+		return "", false
+	}
+	name := scriptOf(r)
+	return name, isSpecificScript(name)
+}
+
+// DominantScript Returns the name of the script most runes of s are written in, as in Scripts, the
+// first name in alphabetical order of equally frequent ones, "" if no rune has a script.
+//  stringutils.DominantScript("")              = ""
+//  stringutils.DominantScript("123")           = ""
+//  stringutils.DominantScript("Hello, мир!")   = "Latin"
+//  stringutils.DominantScript("Привет, world") = "Cyrillic"
+func DominantScript(s string) string {
+	dominant, most := "", 0
+	for name, n := range Scripts(s) {
+		if n > most || n == most && name < dominant {
+			dominant, most = name, n
+		}
+	}
+	return dominant
+}
+
+// IsAllScript Checks if all the runes of s which have a script are of the script t, such as
+// unicode.Han. Common and Inherited characters and whitespace are ignored, false if no rune has a script.
+// With t unicode.Common or unicode.Inherited they are not ignored, so that IsAllScript("123", unicode.Common)
+// is true.
+//  stringutils.IsAllScript("", unicode.Latin)            = false
+//  stringutils.IsAllScript("123", unicode.Latin)         = false
+//  stringutils.IsAllScript("abc 123", unicode.Latin)     = true
+//  stringutils.IsAllScript("漢字。", unicode.Han)        = true
+//  stringutils.IsAllScript("p\u0430ypal", unicode.Latin) = false
+//  stringutils.IsAllScript("123 !", unicode.Common)      = true
+//  stringutils.IsAllScript("abc 123", unicode.Common)    = false
+func IsAllScript(s string, t *unicode.RangeTable) bool {
+	found := false
+	for _, r := range s {
+		if unicode.Is(t, r) {
+			found = true
+			continue
+		}
+		if _, ok := runeScript(r); ok {
+			return false
+		}
+	}
+	return found
+}
+
+// IsAnyScript Checks if any rune of s is of the script t, such as unicode.Cyrillic. With t
+// unicode.Common or unicode.Inherited, whitespace, digits and punctuation or combining marks count.
+//  stringutils.IsAnyScript("", unicode.Cyrillic)            = false
+//  stringutils.IsAnyScript("paypal", unicode.Cyrillic)      = false
+//  stringutils.IsAnyScript("p\u0430ypal", unicode.Cyrillic) = true
+//  stringutils.IsAnyScript("e\u0301", unicode.Inherited)    = true
+func IsAnyScript(s string, t *unicode.RangeTable) bool {
+	for _, r := range s {
+		if unicode.Is(t, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNoneScript Checks if no rune of s is of the script t, such as unicode.Cyrillic.
+//  stringutils.IsNoneScript("", unicode.Cyrillic)            = true
+//  stringutils.IsNoneScript("paypal", unicode.Cyrillic)      = true
+//  stringutils.IsNoneScript("p\u0430ypal", unicode.Cyrillic) = false
+//  stringutils.IsNoneScript("paypal", unicode.Inherited)     = true
+func IsNoneScript(s string, t *unicode.RangeTable) bool {
+	return !IsAnyScript(s, t)
+}
+
+// augmentedScripts returns the scripts of UTS #39 a text in the script also belongs to: Han is
+// written with Hiragana and Katakana in Japanese, with Hangul in Korean and with Bopomofo.
+func augmentedScripts(name string) []string {
+	switch name {
+	case "Han":
+		return []string{name, "Japanese", "Korean", "Han with Bopomofo"}
+	case "Hiragana", "Katakana":
+		return []string{name, "Japanese"}
+	case "Hangul":
+		return []string{name, "Korean"}
+	case "Bopomofo":
+		return []string{name, "Han with Bopomofo"}
+	}
+	return []string{name}
+}
+
+// IsSingleScript Checks if all the runes of s may be written in one script, as the single-script
+// restriction of UTS #39. Common and Inherited characters go with any script, and Han goes with
+// Hiragana and Katakana, Hangul and Bopomofo as in Japanese, Korean and Chinese.
+//  stringutils.IsSingleScript("")               = true
+//  stringutils.IsSingleScript("paypal1")        = true
+//  stringutils.IsSingleScript("p\u0430ypal")    = false
+//  stringutils.IsSingleScript("東京タワー")     = true
+//  stringutils.IsSingleScript("한국어 漢字")    = true
+//  stringutils.IsSingleScript("ひらがな한국어") = false
+func IsSingleScript(s string) bool {
+	var resolved map[string]bool
+	for _, r := range s {
+		name, ok := runeScript(r)
+		if !ok {
+			continue
+		}
+		scripts := augmentedScripts(name)
+		if resolved == nil {
+			resolved = make(map[string]bool, len(scripts))
+			for _, sc := range scripts {
+				resolved[sc] = true
+			}
+			continue
+		}
+		next := make(map[string]bool, len(scripts))
+		for _, sc := range scripts {
+			if resolved[sc] {
+				next[sc] = true
+			}
+		}
+		if len(next) == 0 {
+			return false
+		}
+		resolved = next
+	}
+	return true
+}
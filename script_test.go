@@ -0,0 +1,162 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestScripts(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want map[string]int
+	}{
+		{"empty", "", map[string]int{}},
+		{"common", "123 !?", map[string]int{}},
+		{"whitespace", "\t\u3000\u001c", map[string]int{}},
+		{"latin", "paypal", map[string]int{"Latin": 6}},
+		{"mixed", "p\u0430ypal", map[string]int{"Cyrillic": 1, "Latin": 5}},
+		{"inherited", "e\u0301", map[string]int{"Latin": 1}},
+		{"japanese", "東京タワーへ", map[string]int{"Han": 2, "Hiragana": 1, "Katakana": 2}},
+		{"greek", "αβγ", map[string]int{"Greek": 3}},
+		{"unassigned", "\U000E0FFF", map[string]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scripts(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scripts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDominantScript(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"common", "123 !", ""},
+		{"latin", "Hello, мир!", "Latin"},
+		{"cyrillic", "Привет, world", "Cyrillic"},
+		{"tie", "ab αβ", "Greek"},
+		{"arabic", "مرحبا 123", "Arabic"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DominantScript(tt.s); got != tt.want {
+				t.Errorf("DominantScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAllScript(t *testing.T) {
+	type args struct {
+		s string
+		t *unicode.RangeTable
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", unicode.Latin}, false},
+		{"common", args{"123 !", unicode.Latin}, false},
+		{"latin", args{"abc 123", unicode.Latin}, true},
+		{"han", args{"漢字。", unicode.Han}, true},
+		{"mixed", args{"p\u0430ypal", unicode.Latin}, false},
+		{"combining mark", args{"e\u0301", unicode.Latin}, true},
+		{"common table", args{"123 !", unicode.Common}, true},
+		{"common table mixed", args{"abc 123", unicode.Common}, false},
+		{"common table combining mark", args{"1\u0301", unicode.Common}, true},
+		{"inherited table", args{"\u0301\u0308", unicode.Inherited}, true},
+		{"inherited table letter", args{"e\u0301", unicode.Inherited}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAllScript(tt.args.s, tt.args.t); got != tt.want {
+				t.Errorf("IsAllScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAnyScript(t *testing.T) {
+	type args struct {
+		s string
+		t *unicode.RangeTable
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"empty", args{"", unicode.Cyrillic}, false},
+		{"latin", args{"paypal", unicode.Cyrillic}, false},
+		{"mixed", args{"p\u0430ypal", unicode.Cyrillic}, true},
+		{"common", args{"123", unicode.Common}, true},
+		{"inherited", args{"e\u0301", unicode.Inherited}, true},
+		{"no inherited", args{"paypal", unicode.Inherited}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAnyScript(tt.args.s, tt.args.t); got != tt.want {
+				t.Errorf("IsAnyScript() = %v, want %v", got, tt.want)
+			}
+			if got := IsNoneScript(tt.args.s, tt.args.t); got == tt.want {
+				t.Errorf("IsNoneScript() = %v, want %v", got, !tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSingleScript(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"empty", "", true},
+		{"common", "123 !", true},
+		{"latin", "paypal1", true},
+		{"mixed", "p\u0430ypal", false},
+		{"cyrillic", "\u043f\u0430\u0443\u043f\u0430\u043b", true},
+		{"japanese", "東京タワーへ", true},
+		{"korean", "한국어 漢字", true},
+		{"chinese", "ㄅㄆ 漢字", true},
+		{"hiragana and hangul", "ひらがな한국어", false},
+		{"katakana and bopomofo", "タㄅ", false},
+		{"latin and han", "abc漢字", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSingleScript(tt.s); got != tt.want {
+				t.Errorf("IsSingleScript() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_scriptOf(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'a', "Latin"},
+		{'1', "Common"},
+		{0x0301, "Inherited"},
+		{0x0430, "Cyrillic"},
+		{0x1F600, "Common"},
+		{0x10FFFF, ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.r), func(t *testing.T) {
+			if got := scriptOf(tt.r); got != tt.want {
+				t.Errorf("scriptOf(%U) = %q, want %q", tt.r, got, tt.want)
+			}
+		})
+	}
+}
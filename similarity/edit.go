@@ -0,0 +1,120 @@
+package similarity
+
+import "github.com/Zarket/stringutils"
+
+// Levenshtein is the Levenshtein distance, counting insertions, deletions and substitutions.
+type Levenshtein struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns the Levenshtein distance of a and b.
+//  similarity.Levenshtein{}.Distance("kitten", "sitting") = 3
+func (m Levenshtein) Distance(a, b string) float64 {
+	return float64(stringutils.LevenshteinDistance(string(runes(a, m.FoldCase)), string(runes(b, m.FoldCase))))
+}
+
+// Similarity Returns 1 - Distance(a, b) divided by the rune length of the longer string.
+//  similarity.Levenshtein{}.Similarity("kitten", "sitting") = 0.5714285714285714
+func (m Levenshtein) Similarity(a, b string) float64 {
+	s, t := runes(a, m.FoldCase), runes(b, m.FoldCase)
+	return normalizedSimilarity(stringutils.LevenshteinDistance(string(s), string(t)), len(s), len(t))
+}
+
+// OptimalStringAlignment is the restricted Damerau-Levenshtein distance, counting insertions,
+// deletions, substitutions and transpositions of adjacent runes, where no substring
+// is edited more than once.
+type OptimalStringAlignment struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns the optimal string alignment distance of a and b.
+//  similarity.OptimalStringAlignment{}.Distance("ca", "abc") = 3
+//  similarity.OptimalStringAlignment{}.Distance("ab", "ba")  = 1
+func (m OptimalStringAlignment) Distance(a, b string) float64 {
+	return float64(osa(runes(a, m.FoldCase), runes(b, m.FoldCase)))
+}
+
+// Similarity Returns 1 - Distance(a, b) divided by the rune length of the longer string.
+func (m OptimalStringAlignment) Similarity(a, b string) float64 {
+	s, t := runes(a, m.FoldCase), runes(b, m.FoldCase)
+	return normalizedSimilarity(osa(s, t), len(s), len(t))
+}
+
+func osa(s, t []rune) int {
+	prev2 := make([]int, len(t)+1)
+	prev := make([]int, len(t)+1)
+	cur := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		cur[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(minInt(prev[j]+1, cur[j-1]+1), prev[j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				cur[j] = minInt(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(t)]
+}
+
+// DamerauLevenshtein is the unrestricted Damerau-Levenshtein distance, counting insertions,
+// deletions, substitutions and transpositions of adjacent runes, which may be edited further.
+type DamerauLevenshtein struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns the Damerau-Levenshtein distance of a and b.
+//  similarity.DamerauLevenshtein{}.Distance("ca", "abc") = 2
+//  similarity.DamerauLevenshtein{}.Distance("ab", "ba")  = 1
+func (m DamerauLevenshtein) Distance(a, b string) float64 {
+	return float64(damerauLevenshtein(runes(a, m.FoldCase), runes(b, m.FoldCase)))
+}
+
+// Similarity Returns 1 - Distance(a, b) divided by the rune length of the longer string.
+func (m DamerauLevenshtein) Similarity(a, b string) float64 {
+	s, t := runes(a, m.FoldCase), runes(b, m.FoldCase)
+	return normalizedSimilarity(damerauLevenshtein(s, t), len(s), len(t))
+}
+
+// damerauLevenshtein implements the algorithm of Lowrance and Wagner.
+func damerauLevenshtein(s, t []rune) int {
+	inf := len(s) + len(t)
+	d := make([][]int, len(s)+2)
+	for i := range d {
+		d[i] = make([]int, len(t)+2)
+	}
+	d[0][0] = inf
+	for i := 0; i <= len(s); i++ {
+		d[i+1][0] = inf
+		d[i+1][1] = i
+	}
+	for j := 0; j <= len(t); j++ {
+		d[0][j+1] = inf
+		d[1][j+1] = j
+	}
+	lastRow := make(map[rune]int)
+	for i := 1; i <= len(s); i++ {
+		lastCol := 0
+		for j := 1; j <= len(t); j++ {
+			i1, j1 := lastRow[t[j-1]], lastCol
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			d[i+1][j+1] = minInt(minInt(d[i][j]+cost, d[i+1][j]+1), minInt(d[i][j+1]+1, d[i1][j1]+(i-i1-1)+1+(j-j1-1)))
+		}
+		lastRow[s[i-1]] = i
+	}
+	return d[len(s)+1][len(t)+1]
+}
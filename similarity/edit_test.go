@@ -0,0 +1,98 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLevenshtein_Distance(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		m       Levenshtein
+		args    args
+		want    float64
+		wantSim float64
+	}{
+		{"empty", Levenshtein{}, args{"", ""}, 0, 1},
+		{"kitten,sitting", Levenshtein{}, args{"kitten", "sitting"}, 3, 0.5714285714285714},
+		{"case", Levenshtein{}, args{"ABC", "abc"}, 3, 0},
+		{"fold case", Levenshtein{FoldCase: true}, args{"ABC", "abc"}, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Distance(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Levenshtein.Distance() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.wantSim) > epsilon {
+				t.Errorf("Levenshtein.Similarity() = %v, want %v", got, tt.wantSim)
+			}
+		})
+	}
+}
+
+func TestOptimalStringAlignment_Distance(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		m       OptimalStringAlignment
+		args    args
+		want    float64
+		wantSim float64
+	}{
+		{"empty", OptimalStringAlignment{}, args{"", ""}, 0, 1},
+		{"empty,abc", OptimalStringAlignment{}, args{"", "abc"}, 3, 0},
+		{"ab,ba", OptimalStringAlignment{}, args{"ab", "ba"}, 1, 0.5},
+		{"ca,abc", OptimalStringAlignment{}, args{"ca", "abc"}, 3, 0},
+		{"a cat,an act", OptimalStringAlignment{}, args{"a cat", "an act"}, 2, 0.6666666666666667},
+		{"fold case", OptimalStringAlignment{FoldCase: true}, args{"AB", "ba"}, 1, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Distance(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("OptimalStringAlignment.Distance() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.wantSim) > epsilon {
+				t.Errorf("OptimalStringAlignment.Similarity() = %v, want %v", got, tt.wantSim)
+			}
+		})
+	}
+}
+
+func TestDamerauLevenshtein_Distance(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		m       DamerauLevenshtein
+		args    args
+		want    float64
+		wantSim float64
+	}{
+		{"empty", DamerauLevenshtein{}, args{"", ""}, 0, 1},
+		{"empty,abc", DamerauLevenshtein{}, args{"", "abc"}, 3, 0},
+		{"ab,ba", DamerauLevenshtein{}, args{"ab", "ba"}, 1, 0.5},
+		{"ca,abc", DamerauLevenshtein{}, args{"ca", "abc"}, 2, 0.33333333333333337},
+		{"a cat,an abct", DamerauLevenshtein{}, args{"a cat", "an abct"}, 3, 0.5714285714285714},
+		{"kitten,sitting", DamerauLevenshtein{}, args{"kitten", "sitting"}, 3, 0.5714285714285714},
+		{"runes", DamerauLevenshtein{}, args{"日本", "本日"}, 1, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Distance(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("DamerauLevenshtein.Distance() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.wantSim) > epsilon {
+				t.Errorf("DamerauLevenshtein.Similarity() = %v, want %v", got, tt.wantSim)
+			}
+		})
+	}
+}
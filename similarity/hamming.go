@@ -0,0 +1,37 @@
+package similarity
+
+// Hamming is the Hamming distance, the number of positions at which the runes differ.
+// For strings of different length every rune beyond the shorter string counts as a difference.
+type Hamming struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns the Hamming distance of a and b.
+//  similarity.Hamming{}.Distance("", "")              = 0
+//  similarity.Hamming{}.Distance("pappa", "pappa")    = 0
+//  similarity.Hamming{}.Distance("1011101", "1011111") = 1
+//  similarity.Hamming{}.Distance("ATCG", "ACCC")      = 2
+//  similarity.Hamming{}.Distance("abc", "a")          = 2
+func (m Hamming) Distance(a, b string) float64 {
+	return float64(hamming(runes(a, m.FoldCase), runes(b, m.FoldCase)))
+}
+
+// Similarity Returns 1 - Distance(a, b) divided by the rune length of the longer string.
+func (m Hamming) Similarity(a, b string) float64 {
+	s, t := runes(a, m.FoldCase), runes(b, m.FoldCase)
+	return normalizedSimilarity(hamming(s, t), len(s), len(t))
+}
+
+func hamming(s, t []rune) int {
+	if len(s) < len(t) {
+		s, t = t, s
+	}
+	d := len(s) - len(t)
+	for i := range t {
+		if s[i] != t[i] {
+			d++
+		}
+	}
+	return d
+}
@@ -0,0 +1,39 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHamming_Distance(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name    string
+		m       Hamming
+		args    args
+		want    float64
+		wantSim float64
+	}{
+		{"empty", Hamming{}, args{"", ""}, 0, 1},
+		{"pappa", Hamming{}, args{"pappa", "pappa"}, 0, 1},
+		{"1011101,1011111", Hamming{}, args{"1011101", "1011111"}, 1, 0.8571428571428572},
+		{"ATCG,ACCC", Hamming{}, args{"ATCG", "ACCC"}, 2, 0.5},
+		{"abc,a", Hamming{}, args{"abc", "a"}, 2, 0.33333333333333337},
+		{"case", Hamming{}, args{"ABC", "abc"}, 3, 0},
+		{"fold case", Hamming{FoldCase: true}, args{"ABC", "abc"}, 0, 1},
+		{"runes", Hamming{}, args{"日本語", "日本人"}, 1, 0.6666666666666667},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Distance(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Hamming.Distance() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.wantSim) > epsilon {
+				t.Errorf("Hamming.Similarity() = %v, want %v", got, tt.wantSim)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package similarity
+
+// Jaro is the Jaro similarity, based on the matching runes within a window
+// and the transpositions among them.
+type Jaro struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns 1 - Similarity(a, b).
+func (m Jaro) Distance(a, b string) float64 {
+	return 1 - m.Similarity(a, b)
+}
+
+// Similarity Returns the Jaro similarity of a and b.
+//  similarity.Jaro{}.Similarity("", "")            = 1
+//  similarity.Jaro{}.Similarity("", "a")           = 0
+//  similarity.Jaro{}.Similarity("MARTHA", "MARHTA") = 0.9444444444444445
+//  similarity.Jaro{}.Similarity("DIXON", "DICKSONX") = 0.7666666666666666
+func (m Jaro) Similarity(a, b string) float64 {
+	return jaro(runes(a, m.FoldCase), runes(b, m.FoldCase))
+}
+
+func jaro(s, t []rune) float64 {
+	if len(s) == 0 && len(t) == 0 {
+		return 1
+	}
+	if len(s) == 0 || len(t) == 0 {
+		return 0
+	}
+	window := maxInt(maxInt(len(s), len(t))/2-1, 0)
+	sMatched, tMatched := make([]bool, len(s)), make([]bool, len(t))
+	matches := 0
+	for i := range s {
+		for j := maxInt(0, i-window); j <= minInt(len(t)-1, i+window); j++ {
+			if !tMatched[j] && s[i] == t[j] {
+				sMatched[i], tMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	halfTranspositions, k := 0, 0
+	for i := range s {
+		if !sMatched[i] {
+			continue
+		}
+		for !tMatched[k] {
+			k++
+		}
+		if s[i] != t[k] {
+			halfTranspositions++
+		}
+		k++
+	}
+	m := float64(matches)
+	return (m/float64(len(s)) + m/float64(len(t)) + (m-float64(halfTranspositions)/2)/m) / 3
+}
+
+// JaroWinkler is the Jaro similarity boosted for strings sharing a common prefix
+// of up to four runes. The zero value uses the usual prefix scale of 0.1 and boost
+// threshold of 0.7, NewJaroWinkler sets them to any value, zero included.
+type JaroWinkler struct {
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+	// PrefixScale weights the common prefix, 0.1 if zero unless set by NewJaroWinkler.
+	// It should not exceed 0.25.
+	PrefixScale float64
+	// BoostThreshold is the Jaro similarity above which the prefix boost applies, 0.7 if
+	// zero unless set by NewJaroWinkler.
+	BoostThreshold float64
+	// explicit takes PrefixScale and BoostThreshold as they are, also when zero.
+	explicit bool
+}
+
+// NewJaroWinkler Returns the JaroWinkler with the prefix scale and boost threshold, which are
+// used as given: a prefix scale of 0 is the Jaro similarity and a boost threshold of 0 boosts
+// all the strings with a match.
+//  similarity.NewJaroWinkler(0.1, 0.7).Similarity("MARTHA", "MARHTA") = 0.9611111111111111
+//  similarity.NewJaroWinkler(0, 0.7).Similarity("MARTHA", "MARHTA")   = 0.9444444444444445
+//  similarity.NewJaroWinkler(0.1, 0).Similarity("ab", "ac")           = 0.7
+func NewJaroWinkler(prefixScale, boostThreshold float64) JaroWinkler {
+	return JaroWinkler{PrefixScale: prefixScale, BoostThreshold: boostThreshold, explicit: true}
+}
+
+// Distance Returns 1 - Similarity(a, b).
+func (m JaroWinkler) Distance(a, b string) float64 {
+	return 1 - m.Similarity(a, b)
+}
+
+// Similarity Returns the Jaro-Winkler similarity of a and b.
+//  similarity.JaroWinkler{}.Similarity("", "")               = 1
+//  similarity.JaroWinkler{}.Similarity("MARTHA", "MARHTA")   = 0.9611111111111111
+//  similarity.JaroWinkler{}.Similarity("DIXON", "DICKSONX")  = 0.8133333333333332
+//  similarity.JaroWinkler{FoldCase: true}.Similarity("dwayne", "DUANE") = 0.84
+func (m JaroWinkler) Similarity(a, b string) float64 {
+	s, t := runes(a, m.FoldCase), runes(b, m.FoldCase)
+	j := jaro(s, t)
+	scale, threshold := m.PrefixScale, m.BoostThreshold
+	if !m.explicit {
+		if scale == 0 {
+			scale = 0.1
+		}
+		if threshold == 0 {
+			threshold = 0.7
+		}
+	}
+	if j <= threshold {
+		return j
+	}
+	prefix := 0
+	for prefix < minInt(4, minInt(len(s), len(t))) && s[prefix] == t[prefix] {
+		prefix++
+	}
+	return j + float64(prefix)*scale*(1-j)
+}
@@ -0,0 +1,74 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+const epsilon = 1e-9
+
+func TestJaro_Similarity(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		m    Jaro
+		args args
+		want float64
+	}{
+		{"empty", Jaro{}, args{"", ""}, 1},
+		{"empty,a", Jaro{}, args{"", "a"}, 0},
+		{"equal", Jaro{}, args{"abc", "abc"}, 1},
+		{"no match", Jaro{}, args{"abc", "xyz"}, 0},
+		{"MARTHA,MARHTA", Jaro{}, args{"MARTHA", "MARHTA"}, 0.9444444444444445},
+		{"DIXON,DICKSONX", Jaro{}, args{"DIXON", "DICKSONX"}, 0.7666666666666666},
+		{"case", Jaro{}, args{"martha", "MARTHA"}, 0},
+		{"fold case", Jaro{FoldCase: true}, args{"martha", "MARHTA"}, 0.9444444444444445},
+		{"runes", Jaro{}, args{"日本語", "日本"}, 0.8888888888888888},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("Jaro.Similarity() = %v, want %v", got, tt.want)
+			}
+			if got := tt.m.Distance(tt.args.a, tt.args.b); math.Abs(got-(1-tt.want)) > epsilon {
+				t.Errorf("Jaro.Distance() = %v, want %v", got, 1-tt.want)
+			}
+		})
+	}
+}
+
+func TestJaroWinkler_Similarity(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		m    JaroWinkler
+		args args
+		want float64
+	}{
+		{"empty", JaroWinkler{}, args{"", ""}, 1},
+		{"empty,a", JaroWinkler{}, args{"", "a"}, 0},
+		{"MARTHA,MARHTA", JaroWinkler{}, args{"MARTHA", "MARHTA"}, 0.9611111111111111},
+		{"DIXON,DICKSONX", JaroWinkler{}, args{"DIXON", "DICKSONX"}, 0.8133333333333332},
+		{"DWAYNE,DUANE", JaroWinkler{FoldCase: true}, args{"dwayne", "DUANE"}, 0.84},
+		{"prefix scale", JaroWinkler{PrefixScale: 0.2}, args{"MARTHA", "MARHTA"}, 0.9777777777777777},
+		{"below threshold", JaroWinkler{BoostThreshold: 0.95}, args{"MARTHA", "MARHTA"}, 0.9444444444444445},
+		{"explicit defaults", NewJaroWinkler(0.1, 0.7), args{"MARTHA", "MARHTA"}, 0.9611111111111111},
+		{"zero prefix scale", NewJaroWinkler(0, 0.7), args{"MARTHA", "MARHTA"}, 0.9444444444444445},
+		{"zero threshold", NewJaroWinkler(0.1, 0), args{"ab", "ac"}, 0.7},
+		{"default threshold", JaroWinkler{}, args{"ab", "ac"}, 0.6666666666666666},
+		{"zero threshold no match", NewJaroWinkler(0.1, 0), args{"ab", "cd"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("JaroWinkler.Similarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
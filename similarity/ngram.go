@@ -0,0 +1,93 @@
+package similarity
+
+import "math"
+
+// ngrams returns how often each n-gram of runes occurs in rs. A non-empty string shorter
+// than n is a single n-gram.
+func ngrams(rs []rune, n int) map[string]int {
+	if n <= 0 {
+		n = 2
+	}
+	grams := make(map[string]int)
+	if len(rs) == 0 {
+		return grams
+	}
+	if len(rs) < n {
+		grams[string(rs)]++
+		return grams
+	}
+	for i := 0; i+n <= len(rs); i++ {
+		grams[string(rs[i:i+n])]++
+	}
+	return grams
+}
+
+// Jaccard is the Jaccard index of the sets of n-grams of the strings.
+type Jaccard struct {
+	// N is the n-gram length in runes, 2 if zero.
+	N int
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns 1 - Similarity(a, b).
+func (m Jaccard) Distance(a, b string) float64 {
+	return 1 - m.Similarity(a, b)
+}
+
+// Similarity Returns the size of the intersection divided by the size of the union
+// of the n-gram sets of a and b.
+//  similarity.Jaccard{}.Similarity("", "")         = 1
+//  similarity.Jaccard{}.Similarity("", "a")        = 0
+//  similarity.Jaccard{}.Similarity("night", "nacht") = 0.14285714285714285
+//  similarity.Jaccard{N: 1}.Similarity("ab", "ba") = 1
+func (m Jaccard) Similarity(a, b string) float64 {
+	ga, gb := ngrams(runes(a, m.FoldCase), m.N), ngrams(runes(b, m.FoldCase), m.N)
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+	common := 0
+	for g := range ga {
+		if gb[g] > 0 {
+			common++
+		}
+	}
+	return float64(common) / float64(len(ga)+len(gb)-common)
+}
+
+// Cosine is the cosine similarity of the n-gram frequency vectors of the strings.
+type Cosine struct {
+	// N is the n-gram length in runes, 2 if zero.
+	N int
+	// FoldCase compares the strings case-insensitively.
+	FoldCase bool
+}
+
+// Distance Returns 1 - Similarity(a, b).
+func (m Cosine) Distance(a, b string) float64 {
+	return 1 - m.Similarity(a, b)
+}
+
+// Similarity Returns the cosine of the angle between the n-gram frequency vectors of a and b.
+//  similarity.Cosine{}.Similarity("", "")        = 1
+//  similarity.Cosine{}.Similarity("", "a")       = 0
+//  similarity.Cosine{}.Similarity("abc", "abc")  = 1
+//  similarity.Cosine{N: 1}.Similarity("aab", "ab") = 0.9486832980505138
+func (m Cosine) Similarity(a, b string) float64 {
+	ga, gb := ngrams(runes(a, m.FoldCase), m.N), ngrams(runes(b, m.FoldCase), m.N)
+	if len(ga) == 0 && len(gb) == 0 {
+		return 1
+	}
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for g, ca := range ga {
+		dot += float64(ca * gb[g])
+		normA += float64(ca * ca)
+	}
+	for _, cb := range gb {
+		normB += float64(cb * cb)
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return math.Min(dot/math.Sqrt(normA*normB), 1)
+}
@@ -0,0 +1,61 @@
+package similarity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJaccard_Similarity(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		m    Jaccard
+		args args
+		want float64
+	}{
+		{"empty", Jaccard{}, args{"", ""}, 1},
+		{"empty,a", Jaccard{}, args{"", "a"}, 0},
+		{"night,nacht", Jaccard{}, args{"night", "nacht"}, 0.14285714285714285},
+		{"unigrams", Jaccard{N: 1}, args{"ab", "ba"}, 1},
+		{"short", Jaccard{N: 3}, args{"ab", "ab"}, 1},
+		{"trigrams", Jaccard{N: 3}, args{"abcd", "abce"}, 0.3333333333333333},
+		{"fold case", Jaccard{FoldCase: true}, args{"Night", "NIGHT"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("Jaccard.Similarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCosine_Similarity(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		m    Cosine
+		args args
+		want float64
+	}{
+		{"empty", Cosine{}, args{"", ""}, 1},
+		{"empty,a", Cosine{}, args{"", "a"}, 0},
+		{"equal", Cosine{}, args{"abc", "abc"}, 1},
+		{"unigrams", Cosine{N: 1}, args{"aab", "ab"}, 0.9486832980505138},
+		{"disjoint", Cosine{}, args{"abc", "xyz"}, 0},
+		{"fold case", Cosine{FoldCase: true}, args{"ABC", "abc"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Similarity(tt.args.a, tt.args.b); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("Cosine.Similarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
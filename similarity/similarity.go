@@ -0,0 +1,51 @@
+// Package similarity provides string distance and similarity metrics. All metrics work on runes
+// and can optionally compare the strings with simple Unicode case folding.
+package similarity
+
+import "unicode"
+
+// Metric compares two strings.
+type Metric interface {
+	// Distance returns how different a and b are, 0 for equal strings.
+	Distance(a, b string) float64
+	// Similarity returns how alike a and b are, normalized to [0,1], 1 for equal strings.
+	Similarity(a, b string) float64
+}
+
+// runes returns the runes of s, each mapped to its simple case folding if fold is set.
+// The simple folding keeps one rune per rune, so positions are not shifted.
+func runes(s string, fold bool) []rune {
+	rs := []rune(s)
+	if fold {
+		for i, r := range rs {
+			rs[i] = unicode.ToLower(unicode.ToUpper(r))
+		}
+	}
+	return rs
+}
+
+// normalizedSimilarity turns an edit distance into a similarity in [0,1].
+func normalizedSimilarity(distance, lenA, lenB int) float64 {
+	longest := lenA
+	if lenB > longest {
+		longest = lenB
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(distance)/float64(longest)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
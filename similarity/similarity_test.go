@@ -0,0 +1,23 @@
+package similarity
+
+import "testing"
+
+func TestMetrics(t *testing.T) {
+	metrics := []Metric{
+		Jaro{}, JaroWinkler{}, Levenshtein{}, OptimalStringAlignment{},
+		DamerauLevenshtein{}, Hamming{}, Jaccard{}, Cosine{},
+	}
+	for _, m := range metrics {
+		if got := m.Distance("abc", "abc"); got != 0 {
+			t.Errorf("%T.Distance() of equal strings = %v, want 0", m, got)
+		}
+		if got := m.Similarity("abc", "abc"); got != 1 {
+			t.Errorf("%T.Similarity() of equal strings = %v, want 1", m, got)
+		}
+		for _, pair := range [][2]string{{"", "abc"}, {"abc", "xyz"}, {"kitten", "sitting"}, {"日本", "本日"}} {
+			if got := m.Similarity(pair[0], pair[1]); got < 0 || got > 1 {
+				t.Errorf("%T.Similarity(%q, %q) = %v, want in [0,1]", m, pair[0], pair[1], got)
+			}
+		}
+	}
+}
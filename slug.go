@@ -0,0 +1,71 @@
+package stringutils
+
+import "strings"
+
+// SlugOptions configures Slugify, the zero value gives lower case words joined by "-".
+type SlugOptions struct {
+	// Separator joins the words, "-" if empty.
+	Separator string
+	// MaxLength limits the slug length in bytes, cutting at a word boundary, 0 means no limit. The words
+	// which do not fit are dropped whole, but a first word longer than MaxLength is cut to it, so
+	// that the slug is not empty.
+	MaxLength int
+	// StopWords are left out of the slug, they are transliterated with Table like s, so they are
+	// compared case-insensitively and "für" matches "fur".
+	StopWords []string
+	// Fallback is returned when no word is left.
+	Fallback string
+	// Table is used to transliterate s to ASCII, the default table if nil.
+	Table ASCIITable
+}
+
+// Slugify Returns s as a URL slug: transliterated to ASCII, lower case, the words made of ASCII
+// letters and digits joined by the separator, without separators at either end.
+//  stringutils.Slugify("", stringutils.SlugOptions{})                                       = ""
+//  stringutils.Slugify("   ", stringutils.SlugOptions{Fallback: "untitled"})                = "untitled"
+//  stringutils.Slugify("Hello, World!", stringutils.SlugOptions{})                          = "hello-world"
+//  stringutils.Slugify("Ångström café", stringutils.SlugOptions{})                          = "angstrom-cafe"
+//  stringutils.Slugify("Hello World", stringutils.SlugOptions{Separator: "_"})              = "hello_world"
+//  stringutils.Slugify("the quick fox", stringutils.SlugOptions{MaxLength: 10})             = "the-quick"
+//  stringutils.Slugify("supercalifragilistic", stringutils.SlugOptions{MaxLength: 5})       = "super"
+//  stringutils.Slugify("Tee für zwei", stringutils.SlugOptions{StopWords: []string{"für"}}) = "tee-zwei"
+func Slugify(s string, opts SlugOptions) string {
+	sep := DefaultIfEmpty(opts.Separator, "-")
+	table := opts.Table
+	if table == nil {
+		table = defaultASCIITable
+	}
+	stop := make(map[string]bool, len(opts.StopWords))
+	for _, w := range opts.StopWords {
+		stop[slugText(w, table)] = true
+	}
+	var b strings.Builder
+	for _, w := range strings.FieldsFunc(slugText(s, table), func(r rune) bool { return !isASCIIAlphanumeric(r) }) {
+		if stop[w] {
+			continue
+		}
+		if b.Len() > 0 {
+			if opts.MaxLength > 0 && b.Len()+len(sep)+len(w) > opts.MaxLength {
+				break
+			}
+			b.WriteString(sep)
+		} else if opts.MaxLength > 0 && len(w) > opts.MaxLength {
+			w = w[:opts.MaxLength]
+		}
+		b.WriteString(w)
+	}
+	return DefaultIfBlank(b.String(), opts.Fallback)
+}
+
+// slugText returns s transliterated to ASCII with the table, in lower case and without apostrophes,
+// the text Slugify splits into words.
+func slugText(s string, table ASCIITable) string {
+	s, _ = ToASCIIWithTable(s, table)
+	s = strings.ToLower(s)
+	return strings.NewReplacer("'", "", "`", "").Replace(s)
+}
+
+// isASCIIAlphanumeric reports whether the rune is an ASCII letter or digit.
+func isASCIIAlphanumeric(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
@@ -0,0 +1,43 @@
+package stringutils
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	type args struct {
+		s    string
+		opts SlugOptions
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", SlugOptions{}}, ""},
+		{"blank fallback", args{"   ", SlugOptions{Fallback: "untitled"}}, "untitled"},
+		{"punctuation fallback", args{"?!", SlugOptions{Fallback: "untitled"}}, "untitled"},
+		{"hello world", args{"Hello, World!", SlugOptions{}}, "hello-world"},
+		{"accents", args{"Ångström café", SlugOptions{}}, "angstrom-cafe"},
+		{"cyrillic", args{"Привет мир", SlugOptions{}}, "privet-mir"},
+		{"collapse", args{"  a -- b__c  ", SlugOptions{}}, "a-b-c"},
+		{"apostrophe", args{"Don’t stop", SlugOptions{}}, "dont-stop"},
+		{"separator", args{"Hello World", SlugOptions{Separator: "_"}}, "hello_world"},
+		{"max length", args{"the quick fox", SlugOptions{MaxLength: 10}}, "the-quick"},
+		{"max length exact", args{"the quick fox", SlugOptions{MaxLength: 13}}, "the-quick-fox"},
+		{"max length long word", args{"supercalifragilistic", SlugOptions{MaxLength: 5}}, "super"},
+		{"stop words", args{"The Lord of the Rings", SlugOptions{StopWords: []string{"the", "OF"}}}, "lord-rings"},
+		{"non-ASCII stop words", args{"Tee für zwei", SlugOptions{StopWords: []string{"für"}}}, "tee-zwei"},
+		{"Cyrillic stop words", args{"Война и мир", SlugOptions{StopWords: []string{"И"}}}, "voyna-mir"},
+		{"stop words with apostrophe", args{"l'amour d'Été", SlugOptions{StopWords: []string{"d'été"}}}, "lamour"},
+		{"max length long first word", args{"supercalifragilistic fox", SlugOptions{MaxLength: 5}}, "super"},
+		{"only stop words", args{"the a", SlugOptions{StopWords: []string{"the", "a"}, Fallback: "x"}}, "x"},
+		{"unmapped", args{"日本 2024", SlugOptions{}}, "2024"},
+		{"table", args{"日本", SlugOptions{Table: ASCIITable{'日': "ni", '本': "hon"}}}, "nihon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.args.s, tt.args.opts); got != tt.want {
+				t.Errorf("Slugify() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
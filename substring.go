@@ -0,0 +1,170 @@
+package stringutils
+
+import "strings"
+
+// SubstringBefore Returns the part of s before the first occurrence of sep, s if sep does not occur
+// and "" if sep is empty.
+//  stringutils.SubstringBefore("", "a")      = ""
+//  stringutils.SubstringBefore("abc", "a")   = ""
+//  stringutils.SubstringBefore("abcba", "b") = "a"
+//  stringutils.SubstringBefore("abc", "c")   = "ab"
+//  stringutils.SubstringBefore("abc", "d")   = "abc"
+//  stringutils.SubstringBefore("abc", "")    = ""
+func SubstringBefore(s, sep string) string {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// SubstringBeforeLast Returns the part of s before the last occurrence of sep, s if sep does not
+// occur or is empty.
+//  stringutils.SubstringBeforeLast("", "a")      = ""
+//  stringutils.SubstringBeforeLast("abcba", "b") = "abc"
+//  stringutils.SubstringBeforeLast("abc", "c")   = "ab"
+//  stringutils.SubstringBeforeLast("a", "a")     = ""
+//  stringutils.SubstringBeforeLast("a", "z")     = "a"
+//  stringutils.SubstringBeforeLast("a", "")      = "a"
+func SubstringBeforeLast(s, sep string) string {
+	if sep == "" {
+		return s
+	}
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// SubstringAfter Returns the part of s after the first occurrence of sep, "" if sep does not occur
+// and s if sep is empty.
+//  stringutils.SubstringAfter("", "a")      = ""
+//  stringutils.SubstringAfter("abc", "a")   = "bc"
+//  stringutils.SubstringAfter("abcba", "b") = "cba"
+//  stringutils.SubstringAfter("abc", "c")   = ""
+//  stringutils.SubstringAfter("abc", "d")   = ""
+//  stringutils.SubstringAfter("abc", "")    = "abc"
+func SubstringAfter(s, sep string) string {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[i+len(sep):]
+	}
+	return ""
+}
+
+// SubstringAfterLast Returns the part of s after the last occurrence of sep, "" if sep does not
+// occur or is empty.
+//  stringutils.SubstringAfterLast("", "a")      = ""
+//  stringutils.SubstringAfterLast("abc", "a")   = "bc"
+//  stringutils.SubstringAfterLast("abcba", "b") = "a"
+//  stringutils.SubstringAfterLast("abc", "c")   = ""
+//  stringutils.SubstringAfterLast("a", "z")     = ""
+//  stringutils.SubstringAfterLast("a", "")      = ""
+func SubstringAfterLast(s, sep string) string {
+	if sep == "" {
+		return ""
+	}
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[i+len(sep):]
+	}
+	return ""
+}
+
+// SubstringBetween Returns the part of s between the first occurrence of open and the first
+// occurrence of close after it, false if there is none. An empty open occurs at the start of s and
+// an empty close right after open.
+//  stringutils.SubstringBetween("wx[b]yz", "[", "]")    = "b", true
+//  stringutils.SubstringBetween("yabcz", "y", "z")      = "abc", true
+//  stringutils.SubstringBetween("yabczyabcz", "y", "z") = "abc", true
+//  stringutils.SubstringBetween("yabcz", "", "")        = "", true
+//  stringutils.SubstringBetween("yabcz", "", "z")       = "yabc", true
+//  stringutils.SubstringBetween("", "[", "]")           = "", false
+//  stringutils.SubstringBetween("wx[b", "[", "]")       = "", false
+func SubstringBetween(s, open, close string) (string, bool) {
+	start := strings.Index(s, open)
+	if start < 0 {
+		return "", false
+	}
+	start += len(open)
+	end := strings.Index(s[start:], close)
+	if end < 0 {
+		return "", false
+	}
+	return s[start : start+end], true
+}
+
+// SubstringsBetween Returns the parts of s between each open and the first close after it, in the
+// order they occur, searching again after that close. Nil if there are none or open or close is empty.
+//  stringutils.SubstringsBetween("[a][b][c]", "[", "]")  = ["a" "b" "c"]
+//  stringutils.SubstringsBetween("[a[b]c]", "[", "]")    = ["a[b"]
+//  stringutils.SubstringsBetween("'a' or 'b'", "'", "'") = ["a" "b"]
+//  stringutils.SubstringsBetween("[]", "[", "]")         = [""]
+//  stringutils.SubstringsBetween("abc", "[", "]")        = []
+//  stringutils.SubstringsBetween("[a]", "", "]")         = []
+func SubstringsBetween(s, open, close string) []string {
+	if open == "" || close == "" {
+		return nil
+	}
+	var found []string
+	for {
+		start := strings.Index(s, open)
+		if start < 0 {
+			return found
+		}
+		s = s[start+len(open):]
+		end := strings.Index(s, close)
+		if end < 0 {
+			return found
+		}
+		found = append(found, s[:end])
+		s = s[end+len(close):]
+	}
+}
+
+// SubstringsBetweenNested Returns the parts of s between balanced open and close delimiters, the
+// outermost ones in the order they occur, with the delimiters nested inside them kept. A close
+// without an open and an open which is never closed are not delimiters. If open and close are the
+// same they cannot nest, as in SubstringsBetween. Nil if there are none or open or close is empty.
+//  stringutils.SubstringsBetweenNested("[a[b]c][d]", "[", "]")      = ["a[b]c" "d"]
+//  stringutils.SubstringsBetweenNested("f(g(x), h(y))", "(", ")")   = ["g(x), h(y)"]
+//  stringutils.SubstringsBetweenNested("[a[b]", "[", "]")           = ["b"]
+//  stringutils.SubstringsBetweenNested("]a[b]", "[", "]")           = ["b"]
+//  stringutils.SubstringsBetweenNested("<<a <<b>> c>>", "<<", ">>") = ["a <<b>> c"]
+//  stringutils.SubstringsBetweenNested("abc", "[", "]")             = []
+func SubstringsBetweenNested(s, open, close string) []string {
+	if open == close {
+		return SubstringsBetween(s, open, close)
+	}
+	if open == "" || close == "" {
+		return nil
+	}
+	type span struct{ start, end int }
+	var opens []int
+	var spans []span
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], open):
+			i += len(open)
+			opens = append(opens, i)
+		case strings.HasPrefix(s[i:], close):
+			if len(opens) > 0 {
+				start := opens[len(opens)-1]
+				opens = opens[:len(opens)-1]
+				// the spans closed before are nested in this one
+				for len(spans) > 0 && spans[len(spans)-1].start > start {
+					spans = spans[:len(spans)-1]
+				}
+				spans = append(spans, span{start, i})
+			}
+			i += len(close)
+		default:
+			i++
+		}
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+	found := make([]string, len(spans))
+	for i, sp := range spans {
+		found[i] = s[sp.start:sp.end]
+	}
+	return found
+}
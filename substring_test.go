@@ -0,0 +1,218 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubstringBefore(t *testing.T) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "a"}, ""},
+		{"empty both", args{"", ""}, ""},
+		{"abc a", args{"abc", "a"}, ""},
+		{"abcba b", args{"abcba", "b"}, "a"},
+		{"abc c", args{"abc", "c"}, "ab"},
+		{"abc d", args{"abc", "d"}, "abc"},
+		{"abc empty", args{"abc", ""}, ""},
+		{"multi-byte sep", args{"key::value::x", "::"}, "key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringBefore(tt.args.s, tt.args.sep); got != tt.want {
+				t.Errorf("SubstringBefore() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringBeforeLast(t *testing.T) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "a"}, ""},
+		{"abcba b", args{"abcba", "b"}, "abc"},
+		{"abc c", args{"abc", "c"}, "ab"},
+		{"a a", args{"a", "a"}, ""},
+		{"a z", args{"a", "z"}, "a"},
+		{"a empty", args{"a", ""}, "a"},
+		{"multi-byte sep", args{"key::value::x", "::"}, "key::value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringBeforeLast(tt.args.s, tt.args.sep); got != tt.want {
+				t.Errorf("SubstringBeforeLast() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringAfter(t *testing.T) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "a"}, ""},
+		{"abc a", args{"abc", "a"}, "bc"},
+		{"abcba b", args{"abcba", "b"}, "cba"},
+		{"abc c", args{"abc", "c"}, ""},
+		{"abc d", args{"abc", "d"}, ""},
+		{"abc empty", args{"abc", ""}, "abc"},
+		{"multi-byte sep", args{"key::value::x", "::"}, "value::x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringAfter(tt.args.s, tt.args.sep); got != tt.want {
+				t.Errorf("SubstringAfter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringAfterLast(t *testing.T) {
+	type args struct {
+		s   string
+		sep string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"empty", args{"", "a"}, ""},
+		{"abc a", args{"abc", "a"}, "bc"},
+		{"abcba b", args{"abcba", "b"}, "a"},
+		{"abc c", args{"abc", "c"}, ""},
+		{"a z", args{"a", "z"}, ""},
+		{"a empty", args{"a", ""}, ""},
+		{"multi-byte sep", args{"key::value::x", "::"}, "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringAfterLast(tt.args.s, tt.args.sep); got != tt.want {
+				t.Errorf("SubstringAfterLast() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringBetween(t *testing.T) {
+	type args struct {
+		s     string
+		open  string
+		close string
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   string
+		wantOk bool
+	}{
+		{"brackets", args{"wx[b]yz", "[", "]"}, "b", true},
+		{"yabcz", args{"yabcz", "y", "z"}, "abc", true},
+		{"first", args{"yabczyabcz", "y", "z"}, "abc", true},
+		{"empty open and close", args{"yabcz", "", ""}, "", true},
+		{"empty open", args{"yabcz", "", "z"}, "yabc", true},
+		{"empty close", args{"yabcz", "y", ""}, "", true},
+		{"empty between", args{"[]", "[", "]"}, "", true},
+		{"empty", args{"", "[", "]"}, "", false},
+		{"no close", args{"wx[b", "[", "]"}, "", false},
+		{"close before open", args{"]b[", "[", "]"}, "", false},
+		{"same delimiters", args{"say 'hi' and 'bye'", "'", "'"}, "hi", true},
+		{"tags", args{"<b>bold</b>", "<b>", "</b>"}, "bold", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SubstringBetween(tt.args.s, tt.args.open, tt.args.close)
+			if ok != tt.wantOk {
+				t.Errorf("SubstringBetween() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SubstringBetween() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringsBetween(t *testing.T) {
+	type args struct {
+		s     string
+		open  string
+		close string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{"brackets", args{"[a][b][c]", "[", "]"}, []string{"a", "b", "c"}},
+		{"nested", args{"[a[b]c]", "[", "]"}, []string{"a[b"}},
+		{"same delimiters", args{"'a' or 'b'", "'", "'"}, []string{"a", "b"}},
+		{"empty between", args{"[]", "[", "]"}, []string{""}},
+		{"unclosed", args{"[a][b", "[", "]"}, []string{"a"}},
+		{"none", args{"abc", "[", "]"}, nil},
+		{"empty", args{"", "[", "]"}, nil},
+		{"empty open", args{"[a]", "", "]"}, nil},
+		{"empty close", args{"[a]", "[", ""}, nil},
+		{"tags", args{"<li>a</li><li>b</li>", "<li>", "</li>"}, []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringsBetween(tt.args.s, tt.args.open, tt.args.close); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubstringsBetween() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstringsBetweenNested(t *testing.T) {
+	type args struct {
+		s     string
+		open  string
+		close string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{"nested", args{"[a[b]c][d]", "[", "]"}, []string{"a[b]c", "d"}},
+		{"call", args{"f(g(x), h(y))", "(", ")"}, []string{"g(x), h(y)"}},
+		{"unclosed", args{"[a[b]", "[", "]"}, []string{"b"}},
+		{"unopened", args{"]a[b]", "[", "]"}, []string{"b"}},
+		{"deep", args{"[[[a]]]", "[", "]"}, []string{"[[a]]"}},
+		{"multi-byte", args{"<<a <<b>> c>>", "<<", ">>"}, []string{"a <<b>> c"}},
+		{"same delimiters", args{"'a' or 'b'", "'", "'"}, []string{"a", "b"}},
+		{"none", args{"abc", "[", "]"}, nil},
+		{"only unclosed", args{"[[a", "[", "]"}, nil},
+		{"empty open", args{"[a]", "", "]"}, nil},
+		{"empty close", args{"[a]", "[", ""}, nil},
+		{"empty both", args{"[a]", "", ""}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SubstringsBetweenNested(tt.args.s, tt.args.open, tt.args.close); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubstringsBetweenNested() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
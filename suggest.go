@@ -0,0 +1,117 @@
+package stringutils
+
+import "sort"
+
+// Suggestion is a dictionary word close to the input of Suggester.Suggest.
+type Suggestion struct {
+	// Word is the dictionary word.
+	Word string
+	// Distance is the Levenshtein distance of the input and Word.
+	Distance int
+	// Frequency is the number of times Word was added to the dictionary.
+	Frequency int
+}
+
+// Suggester finds the dictionary words closest to a possibly misspelled input with the
+// symmetric delete algorithm of SymSpell: every word is indexed under all strings reachable
+// by deleting up to the maximum edit distance of runes, a lookup generates the deletes of the
+// input and only verifies the words sharing one of them.
+// A Suggester is safe for concurrent Suggest calls, but not concurrently with Add.
+type Suggester struct {
+	maxDistance int
+	words       map[string]int
+	deletes     map[string][]string
+}
+
+// NewSuggester Returns a Suggester for words, suggesting words within maxDistance edits.
+// A word listed several times gets a higher frequency.
+func NewSuggester(words []string, maxDistance int) *Suggester {
+	if maxDistance < 0 {
+		maxDistance = 0
+	}
+	s := &Suggester{maxDistance: maxDistance, words: make(map[string]int), deletes: make(map[string][]string)}
+	for _, w := range words {
+		s.Add(w, 1)
+	}
+	return s
+}
+
+// Add Adds word to the dictionary, increasing its frequency by frequency. Blank words are ignored.
+func (s *Suggester) Add(word string, frequency int) {
+	if IsBlank(word) {
+		return
+	}
+	if _, ok := s.words[word]; !ok {
+		for d := range runeDeletes(word, s.maxDistance) {
+			s.deletes[d] = append(s.deletes[d], word)
+		}
+	}
+	s.words[word] += frequency
+}
+
+// Suggest Returns the dictionary words within the maximum edit distance of input, the closest first,
+// then the most frequent, then in lexical order. A limit > 0 returns at most limit suggestions.
+// A blank input has no suggestions.
+//  s := stringutils.NewSuggester([]string{"status", "stash", "start"}, 2)
+//  s.Suggest("", 0)       = []
+//  s.Suggest("stauts", 0) = [{start 2 1} {status 2 1}]
+//  s.Suggest("stat", 0)   = [{start 1 1} {stash 2 1} {status 2 1}]
+func (s *Suggester) Suggest(input string, limit int) []Suggestion {
+	if IsBlank(input) {
+		return nil
+	}
+	var suggestions []Suggestion
+	seen := make(map[string]bool)
+	consider := func(word string) {
+		if seen[word] {
+			return
+		}
+		seen[word] = true
+		if d := LevenshteinDistanceWithin(input, word, s.maxDistance); d >= 0 {
+			suggestions = append(suggestions, Suggestion{Word: word, Distance: d, Frequency: s.words[word]})
+		}
+	}
+	for d := range runeDeletes(input, s.maxDistance) {
+		if _, ok := s.words[d]; ok {
+			consider(d)
+		}
+		for _, word := range s.deletes[d] {
+			consider(word)
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		if a.Frequency != b.Frequency {
+			return a.Frequency > b.Frequency
+		}
+		return a.Word < b.Word
+	})
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// runeDeletes returns s and all strings made by deleting up to n runes from s.
+func runeDeletes(s string, n int) map[string]bool {
+	deletes := map[string]bool{s: true}
+	level := []string{s}
+	for ; n > 0 && len(level) > 0; n-- {
+		var next []string
+		for _, w := range level {
+			rs := []rune(w)
+			for i := range rs {
+				d := string(rs[:i]) + string(rs[i+1:])
+				if !deletes[d] {
+					deletes[d] = true
+					next = append(next, d)
+				}
+			}
+		}
+		level = next
+	}
+	return deletes
+}
@@ -0,0 +1,56 @@
+package stringutils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggester_Suggest(t *testing.T) {
+	words := []string{"status", "stash", "start", "commit", "checkout", "cherry-pick", "commit"}
+	type args struct {
+		input string
+		limit int
+	}
+	tests := []struct {
+		name        string
+		maxDistance int
+		args        args
+		want        []Suggestion
+	}{
+		{"empty", 2, args{"", 0}, nil},
+		{"blank", 2, args{"  ", 0}, nil},
+		{"exact", 2, args{"stash", 1}, []Suggestion{{"stash", 0, 1}}},
+		{"transposition", 2, args{"stauts", 0}, []Suggestion{{"start", 2, 1}, {"status", 2, 1}}},
+		{"ranked", 2, args{"stat", 0}, []Suggestion{{"start", 1, 1}, {"stash", 2, 1}, {"status", 2, 1}}},
+		{"frequency", 2, args{"comit", 0}, []Suggestion{{"commit", 1, 2}}},
+		{"limit", 2, args{"stat", 2}, []Suggestion{{"start", 1, 1}, {"stash", 2, 1}}},
+		{"max distance 1", 1, args{"stauts", 0}, nil},
+		{"max distance 0", 0, args{"stash", 0}, []Suggestion{{"stash", 0, 1}}},
+		{"insertion", 1, args{"checkouts", 0}, []Suggestion{{"checkout", 1, 1}}},
+		{"too far", 2, args{"xyz", 0}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSuggester(words, tt.maxDistance)
+			if got := s.Suggest(tt.args.input, tt.args.limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggester_Add(t *testing.T) {
+	s := NewSuggester(nil, 1)
+	s.Add("red", 1)
+	s.Add("rod", 5)
+	s.Add("  ", 10)
+	want := []Suggestion{{"rod", 1, 5}, {"red", 1, 1}}
+	if got := s.Suggest("rad", 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("Suggest() = %v, want %v", got, want)
+	}
+	s.Add("red", 9)
+	want = []Suggestion{{"red", 1, 10}, {"rod", 1, 5}}
+	if got := s.Suggest("rad", 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("Suggest() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package stringutils
+
+import "sort"
+
+// cccRange is a run of code points sharing one Canonical_Combining_Class.
+type cccRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// combiningClass returns the Canonical_Combining_Class of the rune, 0 for starters.
+func combiningClass(r rune) uint8 {
+	i := sort.Search(len(cccTable), func(i int) bool { return cccTable[i].hi >= r })
+	if i < len(cccTable) && cccTable[i].lo <= r {
+		return cccTable[i].ccc
+	}
+	return 0
+}
+
+// graphemeClass is a Grapheme_Cluster_Break value of UAX #29, with Extended_Pictographic as a class of its own.
+type graphemeClass uint8
+
+const (
+	graphemeOther graphemeClass = iota
+	graphemeCR
+	graphemeLF
+	graphemeControl
+	graphemeExtend
+	graphemeZWJ
+	graphemeRegionalIndicator
+	graphemePrepend
+	graphemeSpacingMark
+	graphemeL
+	graphemeV
+	graphemeT
+	graphemeLV
+	graphemeLVT
+	graphemeExtPict
+)
+
+// graphemeRange is a run of code points sharing one graphemeClass.
+type graphemeRange struct {
+	lo, hi rune
+	class  graphemeClass
+}
+
+// graphemeClassOf returns the graphemeClass of the rune.
+func graphemeClassOf(r rune) graphemeClass {
+	i := sort.Search(len(graphemeTable), func(i int) bool { return graphemeTable[i].hi >= r })
+	if i < len(graphemeTable) && graphemeTable[i].lo <= r {
+		return graphemeTable[i].class
+	}
+	return graphemeOther
+}
+
+// emojiProperty is a set of the emoji properties of UTS #51.
+type emojiProperty uint8
+
+const (
+	emojiCharacter emojiProperty = 1 << iota
+	emojiPresentation
+	emojiModifier
+	emojiModifierBase
+	emojiComponent
+)
+
+// emojiRange is a run of code points sharing the same emoji properties.
+type emojiRange struct {
+	lo, hi rune
+	props  emojiProperty
+}
+
+// emojiPropertiesOf returns the emoji properties of the rune.
+func emojiPropertiesOf(r rune) emojiProperty {
+	i := sort.Search(len(emojiTable), func(i int) bool { return emojiTable[i].hi >= r })
+	if i < len(emojiTable) && emojiTable[i].lo <= r {
+		return emojiTable[i].props
+	}
+	return 0
+}